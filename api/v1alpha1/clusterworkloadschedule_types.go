@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterWorkloadScheduleSpec defines the desired state of ClusterWorkloadSchedule
+type ClusterWorkloadScheduleSpec struct {
+	// Timezone specifies the timezone to use for scheduling (e.g., "America/Toronto")
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Timezone string `json:"timezone"`
+
+	// StartHour is the hour (0-23) when the active window begins (inclusive)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	StartHour int `json:"startHour"`
+
+	// EndHour is the hour (0-24) when the active window ends (exclusive)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=24
+	EndHour int `json:"endHour"`
+
+	// NamespaceSelector selects the namespaces whose deployments are scheduled. It must not be
+	// empty; select every namespace explicitly with an Exists expression on kubernetes.io/metadata.name.
+	// +kubebuilder:validation:Required
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// WorkloadSelector selects the deployments within those namespaces by label. All deployments
+	// are selected when it is unset.
+	// +optional
+	WorkloadSelector *metav1.LabelSelector `json:"workloadSelector,omitempty"`
+
+	// ReplicasWhenActive is the number of replicas when within the active window. When unset, every
+	// deployment gets back the replicas it had before the schedule scaled it down.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ReplicasWhenActive *int32 `json:"replicasWhenActive,omitempty"`
+
+	// Priority decides which cluster schedule acts when several select the same deployment.
+	// The highest priority wins; ties go to the schedule whose name sorts first. A WorkloadSchedule
+	// targeting the deployment always takes precedence over cluster schedules.
+	// +kubebuilder:default=0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// Mode is Active to scale the selected deployments or DryRun to only report what would be done
+	// +kubebuilder:default=Active
+	// +optional
+	Mode ScheduleMode `json:"mode,omitempty"`
+}
+
+// ClusterWorkloadScheduleStatus defines the observed state of ClusterWorkloadSchedule
+type ClusterWorkloadScheduleStatus struct {
+	// CurrentLocalTime is the current local time in the specified timezone
+	// +optional
+	CurrentLocalTime string `json:"currentLocalTime,omitempty"`
+
+	// WithinActiveWindow indicates whether the current time is within the active window
+	// +optional
+	WithinActiveWindow bool `json:"withinActiveWindow"`
+
+	// LastSyncTime is the timestamp of the last successful reconciliation
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// NextTransition is the next time the schedule becomes active or inactive
+	// +optional
+	NextTransition *metav1.Time `json:"nextTransition,omitempty"`
+
+	// SelectedDeployments is the number of deployments matching the selectors
+	// +optional
+	SelectedDeployments int32 `json:"selectedDeployments"`
+
+	// ManagedDeployments is the number of selected deployments the schedule acts on. The others are
+	// left to a WorkloadSchedule or to a cluster schedule with precedence.
+	// +optional
+	ManagedDeployments int32 `json:"managedDeployments"`
+
+	// DryRunScales lists the deployments a dry run would currently scale, so each decision is only
+	// announced once
+	// +listType=map
+	// +listMapKey=namespace
+	// +listMapKey=name
+	// +optional
+	DryRunScales []DryRunScale `json:"dryRunScales,omitempty"`
+
+	// Conditions represent the current state of the ClusterWorkloadSchedule resource
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// DryRunScale is a scale a ClusterWorkloadSchedule in dry-run mode would apply to a deployment
+type DryRunScale struct {
+	// Namespace of the deployment
+	Namespace string `json:"namespace"`
+
+	// Name of the deployment
+	Name string `json:"name"`
+
+	// FromReplicas is the current replica count
+	FromReplicas int32 `json:"fromReplicas"`
+
+	// ToReplicas is the replica count the schedule would set
+	ToReplicas int32 `json:"toReplicas"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=cws
+// +kubebuilder:printcolumn:name="Timezone",type=string,JSONPath=`.spec.timezone`
+// +kubebuilder:printcolumn:name="Active",type=boolean,JSONPath=`.status.withinActiveWindow`
+// +kubebuilder:printcolumn:name="Selected",type=integer,JSONPath=`.status.selectedDeployments`
+// +kubebuilder:printcolumn:name="Managed",type=integer,JSONPath=`.status.managedDeployments`
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastSyncTime`
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.mode`,priority=1
+
+// ClusterWorkloadSchedule applies one active window to every deployment matching its namespace and
+// workload selectors, for platform-wide policies such as scaling all dev namespaces down at night
+type ClusterWorkloadSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterWorkloadScheduleSpec   `json:"spec,omitempty"`
+	Status ClusterWorkloadScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterWorkloadScheduleList contains a list of ClusterWorkloadSchedule
+type ClusterWorkloadScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterWorkloadSchedule `json:"items"`
+}
+
+// OriginalReplicasAnnotation records on a deployment the replicas it had before a
+// ClusterWorkloadSchedule scaled it down, so they can be restored when the window opens
+const OriginalReplicasAnnotation = "schedule.illumin.com/original-replicas"
+
+// ScaledByAnnotation records on a deployment the name of the ClusterWorkloadSchedule that holds it
+// scaled down, so the replicas are restored when that schedule is deleted or stops selecting it
+const ScaledByAnnotation = "schedule.illumin.com/scaled-by"
+
+func init() {
+	SchemeBuilder.Register(&ClusterWorkloadSchedule{}, &ClusterWorkloadScheduleList{})
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScheduleTargetGrantSpec defines which WorkloadSchedules from other namespaces may target
+// deployments in the grant's namespace
+type ScheduleTargetGrantSpec struct {
+	// From lists the namespaces whose WorkloadSchedules may target this namespace
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	From []ScheduleTargetGrantFrom `json:"from"`
+
+	// To limits the grant to these deployments. All deployments in the namespace may be targeted
+	// when it is empty. Argo CD Applications are only granted when listed with kind Application.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	// +optional
+	To []ScheduleTargetGrantTo `json:"to,omitempty"`
+}
+
+// ScheduleTargetGrantFrom names a namespace whose schedules are trusted
+type ScheduleTargetGrantFrom struct {
+	// Namespace of the WorkloadSchedules allowed to target this namespace
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+// GrantTargetKind is the kind of object a ScheduleTargetGrant entry names
+// +kubebuilder:validation:Enum=Deployment;Application
+type GrantTargetKind string
+
+const (
+	// GrantTargetKindDeployment grants scaling a deployment
+	GrantTargetKindDeployment GrantTargetKind = "Deployment"
+
+	// GrantTargetKindApplication grants pausing an Argo CD Application while the schedule holds its
+	// deployment down
+	GrantTargetKindApplication GrantTargetKind = "Application"
+)
+
+// ScheduleTargetGrantTo names a deployment or an Argo CD Application that may be targeted
+type ScheduleTargetGrantTo struct {
+	// Kind of the object, Deployment unless set
+	// +kubebuilder:default=Deployment
+	// +optional
+	Kind GrantTargetKind `json:"kind,omitempty"`
+
+	// Name of the deployment or Application
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=stg
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ScheduleTargetGrant allows WorkloadSchedules in other namespaces to target deployments in its own
+// namespace, much like a Gateway API ReferenceGrant. Without one, a schedule may only target
+// deployments in the namespace it lives in.
+type ScheduleTargetGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ScheduleTargetGrantSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScheduleTargetGrantList contains a list of ScheduleTargetGrant
+type ScheduleTargetGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScheduleTargetGrant `json:"items"`
+}
+
+// Permits reports whether the grant lets schedules in fromNamespace target the deployment. The
+// caller must only pass grants from the deployment's namespace.
+func (g *ScheduleTargetGrant) Permits(fromNamespace, deployment string) bool {
+	if !slices.ContainsFunc(g.Spec.From, func(from ScheduleTargetGrantFrom) bool { return from.Namespace == fromNamespace }) {
+		return false
+	}
+	return len(g.Spec.To) == 0 || g.names(GrantTargetKindDeployment, deployment)
+}
+
+// PermitsApplication reports whether the grant lets schedules in fromNamespace pause the Argo CD
+// Application. Unlike deployments, an Application must be listed by name.
+func (g *ScheduleTargetGrant) PermitsApplication(fromNamespace, application string) bool {
+	return slices.ContainsFunc(g.Spec.From, func(from ScheduleTargetGrantFrom) bool { return from.Namespace == fromNamespace }) &&
+		g.names(GrantTargetKindApplication, application)
+}
+
+// names reports whether the grant lists the object of the given kind
+func (g *ScheduleTargetGrant) names(kind GrantTargetKind, name string) bool {
+	return slices.ContainsFunc(g.Spec.To, func(to ScheduleTargetGrantTo) bool {
+		toKind := to.Kind
+		if toKind == "" {
+			toKind = GrantTargetKindDeployment
+		}
+		return toKind == kind && to.Name == name
+	})
+}
+
+// TargetPermitted reports whether the schedule may act on its target: always within its own
+// namespace, otherwise only when one of the grants, listed from the target namespace, permits it
+func TargetPermitted(ws *WorkloadSchedule, grants []ScheduleTargetGrant) bool {
+	if ws.Namespace == ws.Spec.TargetNamespace {
+		return true
+	}
+	for i := range grants {
+		if grants[i].Namespace == ws.Spec.TargetNamespace && grants[i].Permits(ws.Namespace, ws.Spec.TargetDeployment) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplicationPermitted reports whether the schedule may pause its Argo CD Application: always within
+// its own namespace, otherwise only when one of the grants, listed from the Application's namespace,
+// names it. The schedule must reference an Application.
+func ApplicationPermitted(ws *WorkloadSchedule, grants []ScheduleTargetGrant) bool {
+	ref := ws.Spec.Actuation.ArgoCDApplication
+	namespace := ref.ApplicationNamespace()
+	if ws.Namespace == namespace {
+		return true
+	}
+	for i := range grants {
+		if grants[i].Namespace == namespace && grants[i].PermitsApplication(ws.Namespace, ref.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	SchemeBuilder.Register(&ScheduleTargetGrant{}, &ScheduleTargetGrantList{})
+}
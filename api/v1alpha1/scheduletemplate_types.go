@@ -0,0 +1,105 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScheduleTemplateSpec defines active windows shared by every schedule that references the template
+type ScheduleTemplateSpec struct {
+	// Timezone specifies the timezone the windows are evaluated in (e.g., "America/Toronto")
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Timezone string `json:"timezone"`
+
+	// Windows are the hours of a day during which schedules are active. Overlapping or adjacent
+	// windows are treated as one.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=atomic
+	Windows []ScheduleWindow `json:"windows"`
+
+	// Days limits the windows to these days of the week. The windows open every day when it is empty.
+	// +kubebuilder:validation:MaxItems=7
+	// +listType=set
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Holidays are dates on which no window opens
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=map
+	// +listMapKey=date
+	// +optional
+	Holidays []Holiday `json:"holidays,omitempty"`
+}
+
+// ScheduleWindow is a daily active window [StartHour, EndHour)
+// +kubebuilder:validation:XValidation:rule="self.startHour < self.endHour",message="endHour must be greater than startHour"
+type ScheduleWindow struct {
+	// StartHour is the hour (0-23) when the window begins (inclusive)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	StartHour int `json:"startHour"`
+
+	// EndHour is the hour (1-24) when the window ends (exclusive)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=24
+	EndHour int `json:"endHour"`
+}
+
+// Weekday is a day of the week
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type Weekday string
+
+// Holiday is a date on which schedules stay inactive all day
+type Holiday struct {
+	// Date in the template's timezone, formatted as YYYY-MM-DD
+	// +kubebuilder:validation:Pattern=`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`
+	Date string `json:"date"`
+
+	// Name describes the holiday
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=st
+// +kubebuilder:printcolumn:name="Timezone",type=string,JSONPath=`.spec.timezone`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ScheduleTemplate holds active windows that WorkloadSchedules in the same namespace reference by
+// name, so office hours are defined once instead of in every schedule
+type ScheduleTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ScheduleTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScheduleTemplateList contains a list of ScheduleTemplate
+type ScheduleTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScheduleTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScheduleTemplate{}, &ScheduleTemplateList{})
+}
@@ -25,12 +25,673 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Actuation) DeepCopyInto(out *Actuation) {
+	*out = *in
+	if in.Publish != nil {
+		in, out := &in.Publish, &out.Publish
+		*out = make([]PublishTarget, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArgoCDApplication != nil {
+		in, out := &in.ArgoCDApplication, &out.ArgoCDApplication
+		*out = new(ApplicationReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Actuation.
+func (in *Actuation) DeepCopy() *Actuation {
+	if in == nil {
+		return nil
+	}
+	out := new(Actuation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationReference) DeepCopyInto(out *ApplicationReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationReference.
+func (in *ApplicationReference) DeepCopy() *ApplicationReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterScheduleTemplate) DeepCopyInto(out *ClusterScheduleTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterScheduleTemplate.
+func (in *ClusterScheduleTemplate) DeepCopy() *ClusterScheduleTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterScheduleTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterScheduleTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterScheduleTemplateList) DeepCopyInto(out *ClusterScheduleTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterScheduleTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterScheduleTemplateList.
+func (in *ClusterScheduleTemplateList) DeepCopy() *ClusterScheduleTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterScheduleTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterScheduleTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWorkloadSchedule) DeepCopyInto(out *ClusterWorkloadSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkloadSchedule.
+func (in *ClusterWorkloadSchedule) DeepCopy() *ClusterWorkloadSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterWorkloadSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterWorkloadSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWorkloadScheduleList) DeepCopyInto(out *ClusterWorkloadScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterWorkloadSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkloadScheduleList.
+func (in *ClusterWorkloadScheduleList) DeepCopy() *ClusterWorkloadScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterWorkloadScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterWorkloadScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWorkloadScheduleSpec) DeepCopyInto(out *ClusterWorkloadScheduleSpec) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.WorkloadSelector != nil {
+		in, out := &in.WorkloadSelector, &out.WorkloadSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicasWhenActive != nil {
+		in, out := &in.ReplicasWhenActive, &out.ReplicasWhenActive
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkloadScheduleSpec.
+func (in *ClusterWorkloadScheduleSpec) DeepCopy() *ClusterWorkloadScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterWorkloadScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWorkloadScheduleStatus) DeepCopyInto(out *ClusterWorkloadScheduleStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextTransition != nil {
+		in, out := &in.NextTransition, &out.NextTransition
+		*out = (*in).DeepCopy()
+	}
+	if in.DryRunScales != nil {
+		in, out := &in.DryRunScales, &out.DryRunScales
+		*out = make([]DryRunScale, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkloadScheduleStatus.
+func (in *ClusterWorkloadScheduleStatus) DeepCopy() *ClusterWorkloadScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterWorkloadScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainGate) DeepCopyInto(out *DrainGate) {
+	*out = *in
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(DrainHTTPGetAction)
+		**out = **in
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(DrainMetric)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainGate.
+func (in *DrainGate) DeepCopy() *DrainGate {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainHTTPGetAction) DeepCopyInto(out *DrainHTTPGetAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainHTTPGetAction.
+func (in *DrainHTTPGetAction) DeepCopy() *DrainHTTPGetAction {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainHTTPGetAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainMetric) DeepCopyInto(out *DrainMetric) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainMetric.
+func (in *DrainMetric) DeepCopy() *DrainMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainStatus) DeepCopyInto(out *DrainStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainStatus.
+func (in *DrainStatus) DeepCopy() *DrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunScale) DeepCopyInto(out *DryRunScale) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunScale.
+func (in *DryRunScale) DeepCopy() *DryRunScale {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunScale)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Holiday) DeepCopyInto(out *Holiday) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Holiday.
+func (in *Holiday) DeepCopy() *Holiday {
+	if in == nil {
+		return nil
+	}
+	out := new(Holiday)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreWarmStatus) DeepCopyInto(out *PreWarmStatus) {
+	*out = *in
+	in.WindowStart.DeepCopyInto(&out.WindowStart)
+	if in.AvailableTime != nil {
+		in, out := &in.AvailableTime, &out.AvailableTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreWarmStatus.
+func (in *PreWarmStatus) DeepCopy() *PreWarmStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreWarmStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RampStatus) DeepCopyInto(out *RampStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.LastStepTime != nil {
+		in, out := &in.LastStepTime, &out.LastStepTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RampStatus.
+func (in *RampStatus) DeepCopy() *RampStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RampStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RampStrategy) DeepCopyInto(out *RampStrategy) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RampStrategy.
+func (in *RampStrategy) DeepCopy() *RampStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RampStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SavingsStatus) DeepCopyInto(out *SavingsStatus) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	in.LastAccountedTime.DeepCopyInto(&out.LastAccountedTime)
+	out.ReplicaHoursAvoided = in.ReplicaHoursAvoided.DeepCopy()
+	out.CPUCoreHoursAvoided = in.CPUCoreHoursAvoided.DeepCopy()
+	out.MemoryGiBHoursAvoided = in.MemoryGiBHoursAvoided.DeepCopy()
+	if in.EstimatedSavings != nil {
+		in, out := &in.EstimatedSavings, &out.EstimatedSavings
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SavingsStatus.
+func (in *SavingsStatus) DeepCopy() *SavingsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SavingsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleHistoryEntry) DeepCopyInto(out *ScaleHistoryEntry) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleHistoryEntry.
+func (in *ScaleHistoryEntry) DeepCopy() *ScaleHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTargetGrant) DeepCopyInto(out *ScheduleTargetGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTargetGrant.
+func (in *ScheduleTargetGrant) DeepCopy() *ScheduleTargetGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTargetGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScheduleTargetGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTargetGrantFrom) DeepCopyInto(out *ScheduleTargetGrantFrom) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTargetGrantFrom.
+func (in *ScheduleTargetGrantFrom) DeepCopy() *ScheduleTargetGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTargetGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTargetGrantList) DeepCopyInto(out *ScheduleTargetGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScheduleTargetGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTargetGrantList.
+func (in *ScheduleTargetGrantList) DeepCopy() *ScheduleTargetGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTargetGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScheduleTargetGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTargetGrantSpec) DeepCopyInto(out *ScheduleTargetGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]ScheduleTargetGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]ScheduleTargetGrantTo, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTargetGrantSpec.
+func (in *ScheduleTargetGrantSpec) DeepCopy() *ScheduleTargetGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTargetGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTargetGrantTo) DeepCopyInto(out *ScheduleTargetGrantTo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTargetGrantTo.
+func (in *ScheduleTargetGrantTo) DeepCopy() *ScheduleTargetGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTargetGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTemplate) DeepCopyInto(out *ScheduleTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTemplate.
+func (in *ScheduleTemplate) DeepCopy() *ScheduleTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScheduleTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTemplateList) DeepCopyInto(out *ScheduleTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScheduleTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTemplateList.
+func (in *ScheduleTemplateList) DeepCopy() *ScheduleTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScheduleTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTemplateReference) DeepCopyInto(out *ScheduleTemplateReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTemplateReference.
+func (in *ScheduleTemplateReference) DeepCopy() *ScheduleTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTemplateSpec) DeepCopyInto(out *ScheduleTemplateSpec) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]ScheduleWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+	if in.Holidays != nil {
+		in, out := &in.Holidays, &out.Holidays
+		*out = make([]Holiday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTemplateSpec.
+func (in *ScheduleTemplateSpec) DeepCopy() *ScheduleTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleWindow) DeepCopyInto(out *ScheduleWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleWindow.
+func (in *ScheduleWindow) DeepCopy() *ScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadSchedule) DeepCopyInto(out *WorkloadSchedule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -87,6 +748,36 @@ func (in *WorkloadScheduleList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadScheduleSpec) DeepCopyInto(out *WorkloadScheduleSpec) {
 	*out = *in
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(ScheduleTemplateReference)
+		**out = **in
+	}
+	if in.RampStrategy != nil {
+		in, out := &in.RampStrategy, &out.RampStrategy
+		*out = new(RampStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreWarm != nil {
+		in, out := &in.PreWarm, &out.PreWarm
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DrainGate != nil {
+		in, out := &in.DrainGate, &out.DrainGate
+		*out = new(DrainGate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Actuation != nil {
+		in, out := &in.Actuation, &out.Actuation
+		*out = new(Actuation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodMetadata != nil {
+		in, out := &in.PodMetadata, &out.PodMetadata
+		*out = make([]PodMetadataField, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadScheduleSpec.
@@ -106,6 +797,46 @@ func (in *WorkloadScheduleStatus) DeepCopyInto(out *WorkloadScheduleStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.WindowEnd != nil {
+		in, out := &in.WindowEnd, &out.WindowEnd
+		*out = (*in).DeepCopy()
+	}
+	if in.NextTransition != nil {
+		in, out := &in.NextTransition, &out.NextTransition
+		*out = (*in).DeepCopy()
+	}
+	if in.PreWarm != nil {
+		in, out := &in.PreWarm, &out.PreWarm
+		*out = new(PreWarmStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(DrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ramp != nil {
+		in, out := &in.Ramp, &out.Ramp
+		*out = new(RampStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ScaleHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Savings != nil {
+		in, out := &in.Savings, &out.Savings
+		*out = new(SavingsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PausedApplication != nil {
+		in, out := &in.PausedApplication, &out.PausedApplication
+		*out = new(ApplicationReference)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
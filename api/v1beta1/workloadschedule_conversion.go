@@ -0,0 +1,248 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// SpecAnnotation keeps the v1beta1 spec on the v1alpha1 object when v1alpha1 cannot express it, such
+// as several targets or windows, so converting back restores it
+const SpecAnnotation = "infra.illumin.com/v1beta1-spec"
+
+// ConvertTo converts this WorkloadSchedule to the Hub version (v1alpha1). The first target and
+// window map onto the flat v1alpha1 fields; a spec they cannot express is kept in SpecAnnotation.
+func (src *WorkloadSchedule) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1alpha1.WorkloadSchedule)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = convertSpecTo(&src.Spec)
+
+	// The spec is only kept when converting back would not reproduce it
+	if !equality.Semantic.DeepEqual(convertSpecFrom(&dst.Spec), src.Spec) {
+		spec, err := json.Marshal(src.Spec)
+		if err != nil {
+			return fmt.Errorf("failed to keep the v1beta1 spec: %w", err)
+		}
+		dst.Annotations = maps.Clone(dst.Annotations)
+		if dst.Annotations == nil {
+			dst.Annotations = make(map[string]string)
+		}
+		dst.Annotations[SpecAnnotation] = string(spec)
+	}
+
+	dst.Status = infrav1alpha1.WorkloadScheduleStatus{
+		CurrentLocalTime:   src.Status.CurrentLocalTime,
+		WithinActiveWindow: src.Status.WithinActiveWindow,
+		Timezone:           src.Status.Timezone,
+		LastScaleAction:    src.Status.LastScaleAction,
+		LastSyncTime:       src.Status.LastSyncTime,
+		CurrentReplicas:    src.Status.CurrentReplicas,
+		DesiredReplicas:    src.Status.DesiredReplicas,
+		AvailableReplicas:  src.Status.AvailableReplicas,
+		WindowEnd:          src.Status.WindowEnd,
+		NextTransition:     src.Status.NextTransition,
+		PreWarm:            (*infrav1alpha1.PreWarmStatus)(src.Status.PreWarm),
+		Drain:              (*infrav1alpha1.DrainStatus)(src.Status.Drain),
+		Ramp:               (*infrav1alpha1.RampStatus)(src.Status.Ramp),
+		Savings:            (*infrav1alpha1.SavingsStatus)(src.Status.Savings),
+		PausedApplication:  (*infrav1alpha1.ApplicationReference)(src.Status.PausedApplication),
+		Conditions:         src.Status.Conditions,
+	}
+	if src.Status.History != nil {
+		dst.Status.History = make([]infrav1alpha1.ScaleHistoryEntry, 0, len(src.Status.History))
+		for _, entry := range src.Status.History {
+			dst.Status.History = append(dst.Status.History, infrav1alpha1.ScaleHistoryEntry{
+				Time:         entry.Time,
+				FromReplicas: entry.FromReplicas,
+				ToReplicas:   entry.ToReplicas,
+				Reason:       entry.Reason,
+				Trigger:      infrav1alpha1.ScaleTrigger(entry.Trigger),
+				TimeSource:   entry.TimeSource,
+			})
+		}
+	}
+	return nil
+}
+
+// ConvertFrom converts the Hub version (v1alpha1) to this version, turning the flat v1alpha1 fields
+// into a target, a window and behavior. A spec kept in SpecAnnotation is restored as long as the
+// v1alpha1 fields were not changed since.
+func (dst *WorkloadSchedule) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1alpha1.WorkloadSchedule)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = convertSpecFrom(&src.Spec)
+
+	if kept, ok := src.Annotations[SpecAnnotation]; ok {
+		dst.Annotations = maps.Clone(src.Annotations)
+		delete(dst.Annotations, SpecAnnotation)
+		var spec WorkloadScheduleSpec
+		if err := json.Unmarshal([]byte(kept), &spec); err == nil &&
+			equality.Semantic.DeepEqual(convertSpecTo(&spec), src.Spec) {
+			dst.Spec = spec
+		}
+	}
+
+	dst.Status = WorkloadScheduleStatus{
+		CurrentLocalTime:   src.Status.CurrentLocalTime,
+		WithinActiveWindow: src.Status.WithinActiveWindow,
+		Timezone:           src.Status.Timezone,
+		LastScaleAction:    src.Status.LastScaleAction,
+		LastSyncTime:       src.Status.LastSyncTime,
+		CurrentReplicas:    src.Status.CurrentReplicas,
+		DesiredReplicas:    src.Status.DesiredReplicas,
+		AvailableReplicas:  src.Status.AvailableReplicas,
+		WindowEnd:          src.Status.WindowEnd,
+		NextTransition:     src.Status.NextTransition,
+		PreWarm:            (*PreWarmStatus)(src.Status.PreWarm),
+		Drain:              (*DrainStatus)(src.Status.Drain),
+		Ramp:               (*RampStatus)(src.Status.Ramp),
+		Savings:            (*SavingsStatus)(src.Status.Savings),
+		PausedApplication:  (*ApplicationReference)(src.Status.PausedApplication),
+		Conditions:         src.Status.Conditions,
+	}
+	if src.Status.History != nil {
+		dst.Status.History = make([]ScaleHistoryEntry, 0, len(src.Status.History))
+		for _, entry := range src.Status.History {
+			dst.Status.History = append(dst.Status.History, ScaleHistoryEntry{
+				Time:         entry.Time,
+				FromReplicas: entry.FromReplicas,
+				ToReplicas:   entry.ToReplicas,
+				Reason:       entry.Reason,
+				Trigger:      ScaleTrigger(entry.Trigger),
+				TimeSource:   entry.TimeSource,
+			})
+		}
+	}
+	return nil
+}
+
+// convertSpecTo maps the first target and window onto the flat v1alpha1 fields
+func convertSpecTo(src *WorkloadScheduleSpec) infrav1alpha1.WorkloadScheduleSpec {
+	var dst infrav1alpha1.WorkloadScheduleSpec
+	if len(src.Targets) > 0 {
+		dst.TargetNamespace = src.Targets[0].Namespace
+		dst.TargetDeployment = src.Targets[0].Name
+	}
+
+	dst.Timezone = src.Schedule.Timezone
+	if len(src.Schedule.Windows) > 0 {
+		dst.StartHour = src.Schedule.Windows[0].StartHour
+		dst.EndHour = src.Schedule.Windows[0].EndHour
+	}
+	dst.PreWarm = src.Schedule.PreWarm
+	if ref := src.Schedule.TemplateRef; ref != nil {
+		dst.TemplateRef = &infrav1alpha1.ScheduleTemplateReference{
+			Kind: infrav1alpha1.ScheduleTemplateKind(ref.Kind),
+			Name: ref.Name,
+		}
+	}
+
+	behavior := src.Behavior
+	dst.ReplicasWhenActive = behavior.ReplicasWhenActive
+	dst.Priority = behavior.Priority
+	dst.RampStrategy = (*infrav1alpha1.RampStrategy)(behavior.RampStrategy)
+	if gate := behavior.DrainGate; gate != nil {
+		dst.DrainGate = &infrav1alpha1.DrainGate{
+			HTTPGet:     (*infrav1alpha1.DrainHTTPGetAction)(gate.HTTPGet),
+			Metric:      (*infrav1alpha1.DrainMetric)(gate.Metric),
+			Annotation:  gate.Annotation,
+			GracePeriod: gate.GracePeriod,
+		}
+	}
+	dst.Mode = infrav1alpha1.ScheduleMode(behavior.Mode)
+	if actuation := behavior.Actuation; actuation != nil {
+		dst.Actuation = &infrav1alpha1.Actuation{
+			Method:            infrav1alpha1.ActuationMethod(actuation.Method),
+			Publish:           convertSlice[PublishTarget, infrav1alpha1.PublishTarget](actuation.Publish),
+			ArgoCDApplication: (*infrav1alpha1.ApplicationReference)(actuation.ArgoCDApplication),
+		}
+	}
+	dst.ScalePolicy = infrav1alpha1.ScalePolicy(behavior.ScalePolicy)
+	dst.PodInjection = infrav1alpha1.PodInjectionPolicy(behavior.PodInjection)
+	dst.PodMetadata = convertSlice[PodMetadataField, infrav1alpha1.PodMetadataField](behavior.PodMetadata)
+	return dst
+}
+
+// convertSpecFrom turns the flat v1alpha1 fields into a single Deployment target and a single window.
+// A template-based schedule has no window unless the v1alpha1 hours are set.
+func convertSpecFrom(src *infrav1alpha1.WorkloadScheduleSpec) WorkloadScheduleSpec {
+	var dst WorkloadScheduleSpec
+	dst.Targets = []TargetReference{{
+		Kind:      TargetKindDeployment,
+		Namespace: src.TargetNamespace,
+		Name:      src.TargetDeployment,
+	}}
+
+	dst.Schedule = WorkloadScheduleWindows{
+		Timezone: src.Timezone,
+		PreWarm:  src.PreWarm,
+	}
+	if src.TemplateRef == nil || src.StartHour != 0 || src.EndHour != 0 {
+		dst.Schedule.Windows = []ScheduleWindow{{StartHour: src.StartHour, EndHour: src.EndHour}}
+	}
+	if ref := src.TemplateRef; ref != nil {
+		dst.Schedule.TemplateRef = &ScheduleTemplateReference{
+			Kind: ScheduleTemplateKind(ref.Kind),
+			Name: ref.Name,
+		}
+	}
+
+	dst.Behavior = WorkloadScheduleBehavior{
+		ReplicasWhenActive: src.ReplicasWhenActive,
+		Priority:           src.Priority,
+		RampStrategy:       (*RampStrategy)(src.RampStrategy),
+		Mode:               ScheduleMode(src.Mode),
+		ScalePolicy:        ScalePolicy(src.ScalePolicy),
+		PodInjection:       PodInjectionPolicy(src.PodInjection),
+		PodMetadata:        convertSlice[infrav1alpha1.PodMetadataField, PodMetadataField](src.PodMetadata),
+	}
+	if gate := src.DrainGate; gate != nil {
+		dst.Behavior.DrainGate = &DrainGate{
+			HTTPGet:     (*DrainHTTPGetAction)(gate.HTTPGet),
+			Metric:      (*DrainMetric)(gate.Metric),
+			Annotation:  gate.Annotation,
+			GracePeriod: gate.GracePeriod,
+		}
+	}
+	if actuation := src.Actuation; actuation != nil {
+		dst.Behavior.Actuation = &Actuation{
+			Method:            ActuationMethod(actuation.Method),
+			Publish:           convertSlice[infrav1alpha1.PublishTarget, PublishTarget](actuation.Publish),
+			ArgoCDApplication: (*ApplicationReference)(actuation.ArgoCDApplication),
+		}
+	}
+	return dst
+}
+
+// convertSlice converts a list of string enums between versions, keeping a nil list nil
+func convertSlice[From, To ~string](in []From) []To {
+	if in == nil {
+		return nil
+	}
+	out := make([]To, 0, len(in))
+	for _, v := range in {
+		out = append(out, To(v))
+	}
+	return out
+}
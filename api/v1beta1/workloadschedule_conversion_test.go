@@ -0,0 +1,172 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/randfill"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// fuzzIterations is how many random objects each round trip is checked with
+const fuzzIterations = 1000
+
+// quantityFuzzerFuncs fills resource.Quantity through its constructor, since its internal fields
+// only make sense together
+func quantityFuzzerFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		func(q *resource.Quantity, c randfill.Continue) {
+			*q = *resource.NewMilliQuantity(c.Int63n(1000000), resource.DecimalSI)
+		},
+	}
+}
+
+// newFiller returns a filler seeded from the clock, logging the seed so a failure can be replayed
+func newFiller(t *testing.T) *randfill.Filler {
+	seed := time.Now().UnixNano()
+	t.Logf("fuzzing with seed %d", seed)
+	return fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, quantityFuzzerFuncs),
+		rand.NewSource(seed), runtimeserializer.NewCodecFactory(scheme.Scheme))
+}
+
+func TestConvertFromGroupsFlatFields(t *testing.T) {
+	hub := &infrav1alpha1.WorkloadSchedule{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "demo"},
+		Spec: infrav1alpha1.WorkloadScheduleSpec{
+			Timezone:           "America/Toronto",
+			StartHour:          9,
+			EndHour:            17,
+			TargetNamespace:    "demo",
+			TargetDeployment:   "web",
+			ReplicasWhenActive: 3,
+			Mode:               infrav1alpha1.ScheduleModeDryRun,
+		},
+	}
+
+	spoke := &WorkloadSchedule{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatal(err)
+	}
+	want := WorkloadScheduleSpec{
+		Targets: []TargetReference{{Kind: TargetKindDeployment, Namespace: "demo", Name: "web"}},
+		Schedule: WorkloadScheduleWindows{
+			Timezone: "America/Toronto",
+			Windows:  []ScheduleWindow{{StartHour: 9, EndHour: 17}},
+		},
+		Behavior: WorkloadScheduleBehavior{ReplicasWhenActive: 3, Mode: ScheduleModeDryRun},
+	}
+	if !equality.Semantic.DeepEqual(spoke.Spec, want) {
+		t.Errorf("unexpected spec %+v, want %+v", spoke.Spec, want)
+	}
+	if _, ok := spoke.Annotations[SpecAnnotation]; ok {
+		t.Errorf("a spec v1alpha1 can express must not be kept in %s", SpecAnnotation)
+	}
+}
+
+func TestConvertToKeepsWhatV1alpha1CannotExpress(t *testing.T) {
+	spoke := &WorkloadSchedule{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "demo"},
+		Spec: WorkloadScheduleSpec{
+			Targets: []TargetReference{
+				{Kind: TargetKindDeployment, Namespace: "demo", Name: "web"},
+				{Kind: TargetKindDeployment, Namespace: "demo", Name: "worker"},
+			},
+			Schedule: WorkloadScheduleWindows{
+				Timezone: "UTC",
+				Windows:  []ScheduleWindow{{StartHour: 9, EndHour: 12}, {StartHour: 13, EndHour: 17}},
+			},
+			Behavior: WorkloadScheduleBehavior{ReplicasWhenActive: 2},
+		},
+	}
+
+	hub := &infrav1alpha1.WorkloadSchedule{}
+	if err := spoke.DeepCopy().ConvertTo(hub); err != nil {
+		t.Fatal(err)
+	}
+	if hub.Spec.TargetDeployment != "web" || hub.Spec.StartHour != 9 || hub.Spec.EndHour != 12 {
+		t.Errorf("the first target and window must map onto v1alpha1, got %+v", hub.Spec)
+	}
+	if _, ok := hub.Annotations[SpecAnnotation]; !ok {
+		t.Fatalf("expected the spec to be kept in %s", SpecAnnotation)
+	}
+
+	t.Run("dropping it once v1alpha1 fields change", func(t *testing.T) {
+		edited := hub.DeepCopy()
+		edited.Spec.TargetDeployment = "api"
+		restored := &WorkloadSchedule{}
+		if err := restored.ConvertFrom(edited); err != nil {
+			t.Fatal(err)
+		}
+		if len(restored.Spec.Targets) != 1 || restored.Spec.Targets[0].Name != "api" {
+			t.Errorf("expected the edited v1alpha1 target, got %+v", restored.Spec.Targets)
+		}
+		if _, ok := restored.Annotations[SpecAnnotation]; ok {
+			t.Errorf("%s must not leak into v1beta1", SpecAnnotation)
+		}
+	})
+}
+
+func TestHubRoundTrip(t *testing.T) {
+	filler := newFiller(t)
+	for i := 0; i < fuzzIterations; i++ {
+		hub := &infrav1alpha1.WorkloadSchedule{}
+		filler.Fill(hub)
+
+		spoke := &WorkloadSchedule{}
+		if err := spoke.ConvertFrom(hub.DeepCopy()); err != nil {
+			t.Fatal(err)
+		}
+		restored := &infrav1alpha1.WorkloadSchedule{}
+		if err := spoke.ConvertTo(restored); err != nil {
+			t.Fatal(err)
+		}
+		if !equality.Semantic.DeepEqual(restored, hub) {
+			t.Fatalf("v1alpha1 object changed after a round trip through v1beta1:\n%+v\nwant\n%+v", restored, hub)
+		}
+	}
+}
+
+func TestSpokeRoundTrip(t *testing.T) {
+	filler := newFiller(t)
+	for i := 0; i < fuzzIterations; i++ {
+		spoke := &WorkloadSchedule{}
+		filler.Fill(spoke)
+
+		hub := &infrav1alpha1.WorkloadSchedule{}
+		if err := spoke.DeepCopy().ConvertTo(hub); err != nil {
+			t.Fatal(err)
+		}
+		restored := &WorkloadSchedule{}
+		if err := restored.ConvertFrom(hub); err != nil {
+			t.Fatal(err)
+		}
+		if !equality.Semantic.DeepEqual(restored, spoke) {
+			t.Fatalf("v1beta1 object changed after a round trip through v1alpha1:\n%+v\nwant\n%+v", restored, spoke)
+		}
+	}
+}
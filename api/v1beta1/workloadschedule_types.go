@@ -0,0 +1,600 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkloadScheduleSpec defines the desired state of WorkloadSchedule
+type WorkloadScheduleSpec struct {
+	// Targets lists the workloads the schedule scales. A single Deployment referenced by name is
+	// supported for now.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1
+	// +listType=atomic
+	Targets []TargetReference `json:"targets"`
+
+	// Schedule defines when the targets are active
+	// +kubebuilder:validation:Required
+	Schedule WorkloadScheduleWindows `json:"schedule"`
+
+	// Behavior defines how the targets are scaled and what their pods are told about the schedule
+	// +kubebuilder:validation:Required
+	Behavior WorkloadScheduleBehavior `json:"behavior"`
+}
+
+// TargetKind is the kind of workload a schedule scales
+// +kubebuilder:validation:Enum=Deployment
+type TargetKind string
+
+const (
+	// TargetKindDeployment scales a Deployment
+	TargetKindDeployment TargetKind = "Deployment"
+)
+
+// TargetReference identifies the workloads a schedule scales, by name or by label
+// +kubebuilder:validation:XValidation:rule="has(self.name) && size(self.name) > 0 && !has(self.selector)",message="name is required; selecting targets by label is not supported yet"
+type TargetReference struct {
+	// Kind of the workload
+	// +kubebuilder:default=Deployment
+	// +optional
+	Kind TargetKind `json:"kind,omitempty"`
+
+	// Namespace where the workload resides
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Name of the workload
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Selector selects the workloads in the namespace by label instead of by name
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// WorkloadScheduleWindows defines when a schedule is active, either inline or from a template
+// +kubebuilder:validation:XValidation:rule="has(self.templateRef) != (has(self.windows) && size(self.windows) > 0)",message="exactly one of windows or templateRef must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.windows) || size(self.windows) == 0 || (has(self.timezone) && size(self.timezone) > 0)",message="timezone is required with windows"
+type WorkloadScheduleWindows struct {
+	// Timezone specifies the timezone to use for scheduling (e.g., "America/Toronto")
+	// This timezone will be used to query the worldtimeapi.org API. It is required with Windows.
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// Windows lists the daily active windows. A single window is supported for now; use a template
+	// for several.
+	// +kubebuilder:validation:MaxItems=1
+	// +listType=atomic
+	// +optional
+	Windows []ScheduleWindow `json:"windows,omitempty"`
+
+	// TemplateRef takes the timezone, windows, days and holidays from a ScheduleTemplate or
+	// ClusterScheduleTemplate instead of Timezone and Windows
+	// +optional
+	TemplateRef *ScheduleTemplateReference `json:"templateRef,omitempty"`
+
+	// PreWarm starts scaling up this long before the window opens so the targets are ready in
+	// time (e.g. "10m" for services with slow startup)
+	// +optional
+	PreWarm *metav1.Duration `json:"preWarm,omitempty"`
+}
+
+// ScheduleWindow is a daily period during which the targets are active
+type ScheduleWindow struct {
+	// StartHour is the hour (0-23) when the window begins (inclusive)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	StartHour int `json:"startHour"`
+
+	// EndHour is the hour (0-24) when the window ends (exclusive)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=24
+	EndHour int `json:"endHour"`
+}
+
+// WorkloadScheduleBehavior defines how a schedule scales its target
+type WorkloadScheduleBehavior struct {
+	// ReplicasWhenActive is the number of replicas when within the active window
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	ReplicasWhenActive int32 `json:"replicasWhenActive"`
+
+	// Priority decides which schedule acts when several target the same deployment.
+	// The highest priority wins; ties go to the oldest schedule, then to namespace/name order.
+	// +kubebuilder:default=0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// RampStrategy walks replicas toward the target over several reconciles instead of
+	// jumping straight to it. When unset, the deployment is scaled in a single update.
+	// +optional
+	RampStrategy *RampStrategy `json:"rampStrategy,omitempty"`
+
+	// DrainGate delays lowering replicas until the target's pods report they are idle,
+	// or until the grace period runs out. When unset, scale-down happens immediately.
+	// +optional
+	DrainGate *DrainGate `json:"drainGate,omitempty"`
+
+	// Mode is Active to scale the target, or DryRun to only report what would be done in the status
+	// and Events without changing the target. A DryRun schedule does not enforce its ScalePolicy.
+	// +kubebuilder:default=Active
+	// +optional
+	Mode ScheduleMode `json:"mode,omitempty"`
+
+	// Actuation controls how the desired replicas reach the target. By default the controller scales
+	// the deployment itself.
+	// +optional
+	Actuation *Actuation `json:"actuation,omitempty"`
+
+	// ScalePolicy decides whether manual replica increases of the target are allowed while the
+	// schedule is inactive. With Enforce, the Deployment webhook rejects them unless the Deployment
+	// carries the break-glass annotation.
+	// +kubebuilder:default=Allow
+	// +optional
+	ScalePolicy ScalePolicy `json:"scalePolicy,omitempty"`
+
+	// PodInjection controls what the Pod webhook adds to the target's pods: Full injects the
+	// label, annotations, environment variables and state volume, LabelOnly only the label and
+	// annotations, and Disabled leaves the pods untouched
+	// +kubebuilder:default=Full
+	// +optional
+	PodInjection PodInjectionPolicy `json:"podInjection,omitempty"`
+
+	// PodMetadata lists the schedule details the Pod webhook injects into the target's pods as
+	// environment variables and annotations, in addition to the active flag. Nothing extra is
+	// injected when empty.
+	// +listType=set
+	// +optional
+	PodMetadata []PodMetadataField `json:"podMetadata,omitempty"`
+}
+
+// ScheduleTemplateKind is the kind of template a schedule references
+// +kubebuilder:validation:Enum=ScheduleTemplate;ClusterScheduleTemplate
+type ScheduleTemplateKind string
+
+const (
+	// ScheduleTemplateKindNamespaced references a ScheduleTemplate in the schedule's namespace
+	ScheduleTemplateKindNamespaced ScheduleTemplateKind = "ScheduleTemplate"
+
+	// ScheduleTemplateKindCluster references a ClusterScheduleTemplate
+	ScheduleTemplateKindCluster ScheduleTemplateKind = "ClusterScheduleTemplate"
+)
+
+// ScheduleTemplateReference names the template a schedule takes its windows from
+type ScheduleTemplateReference struct {
+	// Kind is ScheduleTemplate, looked up in the schedule's namespace, or ClusterScheduleTemplate
+	// +kubebuilder:default=ScheduleTemplate
+	// +optional
+	Kind ScheduleTemplateKind `json:"kind,omitempty"`
+
+	// Name of the template
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// ScheduleMode controls whether the controller acts on its decisions
+// +kubebuilder:validation:Enum=Active;DryRun
+type ScheduleMode string
+
+const (
+	// ScheduleModeActive scales the target deployment
+	ScheduleModeActive ScheduleMode = "Active"
+
+	// ScheduleModeDryRun computes and reports decisions without changing the target deployment
+	ScheduleModeDryRun ScheduleMode = "DryRun"
+)
+
+// ActuationMethod is how the controller applies the desired replicas
+// +kubebuilder:validation:Enum=Scale;GitOps
+type ActuationMethod string
+
+const (
+	// ActuationMethodScale changes the replicas of the target deployment
+	ActuationMethodScale ActuationMethod = "Scale"
+
+	// ActuationMethodGitOps only publishes the desired replicas for a GitOps tool to apply
+	ActuationMethodGitOps ActuationMethod = "GitOps"
+)
+
+// PublishTarget is where the GitOps actuation method writes the desired replicas
+// +kubebuilder:validation:Enum=Annotation;ConfigMap
+type PublishTarget string
+
+const (
+	// PublishTargetAnnotation writes the desired replicas to an annotation of the target deployment
+	PublishTargetAnnotation PublishTarget = "Annotation"
+
+	// PublishTargetConfigMap adds the desired replicas to the state ConfigMap of the target deployment
+	PublishTargetConfigMap PublishTarget = "ConfigMap"
+)
+
+// Actuation controls how the desired replicas reach the target deployment
+type Actuation struct {
+	// Method is Scale to change the replicas of the target, or GitOps to leave them to a GitOps tool
+	// and only publish the desired replicas where it can read them
+	// +kubebuilder:default=Scale
+	// +optional
+	Method ActuationMethod `json:"method,omitempty"`
+
+	// Publish lists where the GitOps method writes the desired replicas. Defaults to Annotation.
+	// +listType=set
+	// +optional
+	Publish []PublishTarget `json:"publish,omitempty"`
+
+	// ArgoCDApplication is the Argo CD Application that deploys the target. Its reconciliation is
+	// paused while the schedule is inactive, so a sync does not revert the scale-down. Only used with
+	// the Scale method.
+	// +optional
+	ArgoCDApplication *ApplicationReference `json:"argoCDApplication,omitempty"`
+}
+
+// ApplicationReference identifies an Argo CD Application
+type ApplicationReference struct {
+	// Namespace of the Application
+	// +kubebuilder:default=argocd
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the Application
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// ScalePolicy controls how manual scaling of the target is treated outside the active window
+// +kubebuilder:validation:Enum=Allow;Enforce
+type ScalePolicy string
+
+const (
+	// ScalePolicyAllow lets anyone scale the target at any time
+	ScalePolicyAllow ScalePolicy = "Allow"
+
+	// ScalePolicyEnforce rejects manual replica increases while the schedule is inactive
+	ScalePolicyEnforce ScalePolicy = "Enforce"
+)
+
+// PodInjectionPolicy controls how much the Pod webhook mutates the target's pods
+// +kubebuilder:validation:Enum=Disabled;LabelOnly;Full
+type PodInjectionPolicy string
+
+const (
+	// PodInjectionDisabled leaves the target's pods untouched
+	PodInjectionDisabled PodInjectionPolicy = "Disabled"
+
+	// PodInjectionLabelOnly adds the active label and annotations but does not modify containers or volumes
+	PodInjectionLabelOnly PodInjectionPolicy = "LabelOnly"
+
+	// PodInjectionFull adds the label, annotations, environment variables and the state volume
+	PodInjectionFull PodInjectionPolicy = "Full"
+)
+
+// PodMetadataField is a schedule detail that can be injected into pods
+// +kubebuilder:validation:Enum=ScheduleName;WindowEnd;NextTransition;Timezone
+type PodMetadataField string
+
+const (
+	// PodMetadataScheduleName injects the name of the applied schedule
+	PodMetadataScheduleName PodMetadataField = "ScheduleName"
+
+	// PodMetadataWindowEnd injects the end of the current, or next, active window
+	PodMetadataWindowEnd PodMetadataField = "WindowEnd"
+
+	// PodMetadataNextTransition injects the next time the schedule becomes active or inactive
+	PodMetadataNextTransition PodMetadataField = "NextTransition"
+
+	// PodMetadataTimezone injects the schedule's timezone
+	PodMetadataTimezone PodMetadataField = "Timezone"
+)
+
+// RampStrategy defines how replicas are stepped toward the desired count
+type RampStrategy struct {
+	// StepSize is the maximum number of replicas added or removed per step.
+	// Ignored when Duration is set.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	StepSize int32 `json:"stepSize,omitempty"`
+
+	// Interval is the minimum time between two steps (defaults to 1m)
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Duration is the total time a ramp should take. When set, the step size is derived
+	// from the replica delta so that the target is reached after Duration/Interval steps.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// DrainGate defines how the controller decides that the target's pods have no in-flight work.
+// Exactly one of HTTPGet, Metric or Annotation must be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.httpGet), has(self.metric), has(self.annotation)].filter(x, x).size() == 1",message="exactly one of httpGet, metric or annotation must be set"
+type DrainGate struct {
+	// HTTPGet probes an endpoint on each pod; a 2xx response means the pod is idle
+	// +optional
+	HTTPGet *DrainHTTPGetAction `json:"httpGet,omitempty"`
+
+	// Metric reads a Prometheus-style metric exposed by each pod; the pod is idle when
+	// the sum of its samples is zero (e.g. an in-flight requests gauge)
+	// +optional
+	Metric *DrainMetric `json:"metric,omitempty"`
+
+	// Annotation is a pod annotation key; the pod is idle when the annotation is set to "true"
+	// +optional
+	Annotation string `json:"annotation,omitempty"`
+
+	// GracePeriod is the maximum time to wait for the pods to become idle before
+	// scaling down anyway (defaults to 5m)
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// DrainHTTPGetAction describes an HTTP endpoint on the target's pods that reports idleness
+type DrainHTTPGetAction struct {
+	// Path to request on the pod
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+
+	// Port to connect to on the pod IP
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Scheme to use when connecting to the pod (defaults to HTTP)
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	// +kubebuilder:default=HTTP
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
+}
+
+// DrainMetric describes a Prometheus-style metric on the target's pods that reports in-flight work
+type DrainMetric struct {
+	// Name of the metric to read (e.g. "http_requests_in_flight")
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Port the metrics endpoint listens on
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Path of the metrics endpoint (defaults to /metrics)
+	// +kubebuilder:default="/metrics"
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// WorkloadScheduleStatus defines the observed state of WorkloadSchedule
+type WorkloadScheduleStatus struct {
+	// CurrentLocalTime is the current local time in the specified timezone
+	// +optional
+	CurrentLocalTime string `json:"currentLocalTime,omitempty"`
+
+	// WithinActiveWindow indicates whether the current time is within the active window
+	// +optional
+	WithinActiveWindow bool `json:"withinActiveWindow"`
+
+	// Timezone is the timezone the schedule was last evaluated in, taken from its template when it
+	// has a TemplateRef
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// LastScaleAction describes the last scaling action taken
+	// +optional
+	LastScaleAction string `json:"lastScaleAction,omitempty"`
+
+	// LastSyncTime is the timestamp of the last successful reconciliation
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// CurrentReplicas is the current number of replicas of the target deployment
+	// +optional
+	CurrentReplicas int32 `json:"currentReplicas"`
+
+	// DesiredReplicas is the number of replicas the schedule wants the target deployment to have
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas"`
+
+	// AvailableReplicas is the number of available replicas of the target deployment
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+
+	// WindowEnd is the end of the current active window, or of the next one while inactive
+	// +optional
+	WindowEnd *metav1.Time `json:"windowEnd,omitempty"`
+
+	// NextTransition is the next time the schedule becomes active or inactive
+	// +optional
+	NextTransition *metav1.Time `json:"nextTransition,omitempty"`
+
+	// PreWarm reports whether the most recently pre-warmed window was ready in time
+	// +optional
+	PreWarm *PreWarmStatus `json:"preWarm,omitempty"`
+
+	// Drain tracks a scale-down that is waiting for in-flight work to finish; it is cleared once replicas are lowered
+	// +optional
+	Drain *DrainStatus `json:"drain,omitempty"`
+
+	// Ramp tracks the progress of a gradual scale operation; it is cleared once the target is reached
+	// +optional
+	Ramp *RampStatus `json:"ramp,omitempty"`
+
+	// History lists the most recent replica changes made by the controller, newest first. Reconciles
+	// that leave the replicas unchanged are not recorded.
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=atomic
+	// +optional
+	History []ScaleHistoryEntry `json:"history,omitempty"`
+
+	// Savings accumulates the capacity the schedule avoided running compared to keeping
+	// ReplicasWhenActive replicas around the clock
+	// +optional
+	Savings *SavingsStatus `json:"savings,omitempty"`
+
+	// PausedApplication is the Argo CD Application the schedule paused, so the pause is lifted even
+	// after the reference is removed
+	// +optional
+	PausedApplication *ApplicationReference `json:"pausedApplication,omitempty"`
+
+	// Conditions represent the current state of the WorkloadSchedule resource
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// DrainStatus tracks a scale-down that is waiting on the drain gate
+type DrainStatus struct {
+	// StartTime is when the controller started waiting for the pods to become idle
+	StartTime metav1.Time `json:"startTime"`
+
+	// BusyPods is the number of pods that still reported in-flight work at the last check
+	BusyPods int32 `json:"busyPods"`
+}
+
+// ScaleTrigger is what caused the controller to change the replicas of the target
+type ScaleTrigger string
+
+const (
+	// ScaleTriggerWindowOpened is a scale-up because the active window started
+	ScaleTriggerWindowOpened ScaleTrigger = "WindowOpened"
+
+	// ScaleTriggerWindowClosed is a scale-down because the active window ended
+	ScaleTriggerWindowClosed ScaleTrigger = "WindowClosed"
+
+	// ScaleTriggerPreWarm is a scale-up ahead of the active window
+	ScaleTriggerPreWarm ScaleTrigger = "PreWarm"
+
+	// ScaleTriggerRamp is a later step of a gradual scale operation
+	ScaleTriggerRamp ScaleTrigger = "Ramp"
+
+	// ScaleTriggerDrift is a correction of replicas that were changed outside the schedule
+	ScaleTriggerDrift ScaleTrigger = "Drift"
+)
+
+// ScaleHistoryEntry records one replica change of the target deployment
+type ScaleHistoryEntry struct {
+	// Time is when the replicas were changed
+	Time metav1.Time `json:"time"`
+
+	// FromReplicas is the replica count before the change
+	FromReplicas int32 `json:"fromReplicas"`
+
+	// ToReplicas is the replica count after the change
+	ToReplicas int32 `json:"toReplicas"`
+
+	// Reason is ScaledUp or ScaledDown, matching the Event emitted for the change
+	Reason string `json:"reason"`
+
+	// Trigger is what caused the change
+	Trigger ScaleTrigger `json:"trigger"`
+
+	// TimeSource is where the time that drove the decision came from
+	TimeSource string `json:"timeSource"`
+}
+
+// SavingsStatus is the cumulative capacity avoided by a schedule. Replicas below ReplicasWhenActive
+// count as avoided, weighted by the resource requests of the target's pod template.
+type SavingsStatus struct {
+	// Since is when the schedule started accounting savings
+	Since metav1.Time `json:"since"`
+
+	// LastAccountedTime is the end of the last interval added to the totals
+	LastAccountedTime metav1.Time `json:"lastAccountedTime"`
+
+	// ReplicaHoursAvoided is the total of replicas not running, in replica-hours
+	ReplicaHoursAvoided resource.Quantity `json:"replicaHoursAvoided"`
+
+	// CPUCoreHoursAvoided is the total of CPU requests not running, in core-hours
+	CPUCoreHoursAvoided resource.Quantity `json:"cpuCoreHoursAvoided"`
+
+	// MemoryGiBHoursAvoided is the total of memory requests not running, in GiB-hours
+	MemoryGiBHoursAvoided resource.Quantity `json:"memoryGiBHoursAvoided"`
+
+	// EstimatedSavings is the avoided CPU and memory priced at the configured rates. It is only
+	// set when the operator is configured with prices.
+	// +optional
+	EstimatedSavings *resource.Quantity `json:"estimatedSavings,omitempty"`
+
+	// Currency is the currency of EstimatedSavings
+	// +optional
+	Currency string `json:"currency,omitempty"`
+}
+
+// RampStatus tracks the progress of a gradual scale operation
+type RampStatus struct {
+	// FromReplicas is the replica count when the ramp started
+	FromReplicas int32 `json:"fromReplicas"`
+
+	// TargetReplicas is the replica count the ramp is walking toward
+	TargetReplicas int32 `json:"targetReplicas"`
+
+	// StartTime is when the ramp started
+	StartTime metav1.Time `json:"startTime"`
+
+	// LastStepTime is when the most recent step was applied
+	// +optional
+	LastStepTime *metav1.Time `json:"lastStepTime,omitempty"`
+}
+
+// PreWarmStatus reports the readiness of the target ahead of a pre-warmed window
+type PreWarmStatus struct {
+	// WindowStart is the start of the active window being pre-warmed
+	WindowStart metav1.Time `json:"windowStart"`
+
+	// AvailableTime is when the target first reported ReplicasWhenActive available replicas
+	// +optional
+	AvailableTime *metav1.Time `json:"availableTime,omitempty"`
+
+	// AvailableBeforeStart indicates whether the target became available before WindowStart
+	AvailableBeforeStart bool `json:"availableBeforeStart"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Timezone",type=string,JSONPath=`.status.timezone`
+// +kubebuilder:printcolumn:name="Active",type=boolean,JSONPath=`.status.withinActiveWindow`
+// +kubebuilder:printcolumn:name="Replicas",type=integer,JSONPath=`.status.currentReplicas`
+// +kubebuilder:printcolumn:name="Last Transition",type=date,JSONPath=`.status.history[0].time`
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastSyncTime`
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.behavior.mode`,priority=1
+// +kubebuilder:printcolumn:name="Template",type=string,JSONPath=`.spec.schedule.templateRef.name`,priority=1
+
+// WorkloadSchedule is the Schema for the workloadschedules API
+type WorkloadSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkloadScheduleSpec   `json:"spec,omitempty"`
+	Status WorkloadScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkloadScheduleList contains a list of WorkloadSchedule
+type WorkloadScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkloadSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkloadSchedule{}, &WorkloadScheduleList{})
+}
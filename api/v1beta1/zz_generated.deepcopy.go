@@ -0,0 +1,512 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Actuation) DeepCopyInto(out *Actuation) {
+	*out = *in
+	if in.Publish != nil {
+		in, out := &in.Publish, &out.Publish
+		*out = make([]PublishTarget, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArgoCDApplication != nil {
+		in, out := &in.ArgoCDApplication, &out.ArgoCDApplication
+		*out = new(ApplicationReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Actuation.
+func (in *Actuation) DeepCopy() *Actuation {
+	if in == nil {
+		return nil
+	}
+	out := new(Actuation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationReference) DeepCopyInto(out *ApplicationReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationReference.
+func (in *ApplicationReference) DeepCopy() *ApplicationReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainGate) DeepCopyInto(out *DrainGate) {
+	*out = *in
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(DrainHTTPGetAction)
+		**out = **in
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(DrainMetric)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainGate.
+func (in *DrainGate) DeepCopy() *DrainGate {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainHTTPGetAction) DeepCopyInto(out *DrainHTTPGetAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainHTTPGetAction.
+func (in *DrainHTTPGetAction) DeepCopy() *DrainHTTPGetAction {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainHTTPGetAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainMetric) DeepCopyInto(out *DrainMetric) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainMetric.
+func (in *DrainMetric) DeepCopy() *DrainMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainStatus) DeepCopyInto(out *DrainStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainStatus.
+func (in *DrainStatus) DeepCopy() *DrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreWarmStatus) DeepCopyInto(out *PreWarmStatus) {
+	*out = *in
+	in.WindowStart.DeepCopyInto(&out.WindowStart)
+	if in.AvailableTime != nil {
+		in, out := &in.AvailableTime, &out.AvailableTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreWarmStatus.
+func (in *PreWarmStatus) DeepCopy() *PreWarmStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreWarmStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RampStatus) DeepCopyInto(out *RampStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.LastStepTime != nil {
+		in, out := &in.LastStepTime, &out.LastStepTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RampStatus.
+func (in *RampStatus) DeepCopy() *RampStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RampStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RampStrategy) DeepCopyInto(out *RampStrategy) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RampStrategy.
+func (in *RampStrategy) DeepCopy() *RampStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RampStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SavingsStatus) DeepCopyInto(out *SavingsStatus) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	in.LastAccountedTime.DeepCopyInto(&out.LastAccountedTime)
+	out.ReplicaHoursAvoided = in.ReplicaHoursAvoided.DeepCopy()
+	out.CPUCoreHoursAvoided = in.CPUCoreHoursAvoided.DeepCopy()
+	out.MemoryGiBHoursAvoided = in.MemoryGiBHoursAvoided.DeepCopy()
+	if in.EstimatedSavings != nil {
+		in, out := &in.EstimatedSavings, &out.EstimatedSavings
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SavingsStatus.
+func (in *SavingsStatus) DeepCopy() *SavingsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SavingsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleHistoryEntry) DeepCopyInto(out *ScaleHistoryEntry) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleHistoryEntry.
+func (in *ScaleHistoryEntry) DeepCopy() *ScaleHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTemplateReference) DeepCopyInto(out *ScheduleTemplateReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTemplateReference.
+func (in *ScheduleTemplateReference) DeepCopy() *ScheduleTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleWindow) DeepCopyInto(out *ScheduleWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleWindow.
+func (in *ScheduleWindow) DeepCopy() *ScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetReference) DeepCopyInto(out *TargetReference) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetReference.
+func (in *TargetReference) DeepCopy() *TargetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadSchedule) DeepCopyInto(out *WorkloadSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSchedule.
+func (in *WorkloadSchedule) DeepCopy() *WorkloadSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadScheduleBehavior) DeepCopyInto(out *WorkloadScheduleBehavior) {
+	*out = *in
+	if in.RampStrategy != nil {
+		in, out := &in.RampStrategy, &out.RampStrategy
+		*out = new(RampStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DrainGate != nil {
+		in, out := &in.DrainGate, &out.DrainGate
+		*out = new(DrainGate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Actuation != nil {
+		in, out := &in.Actuation, &out.Actuation
+		*out = new(Actuation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodMetadata != nil {
+		in, out := &in.PodMetadata, &out.PodMetadata
+		*out = make([]PodMetadataField, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadScheduleBehavior.
+func (in *WorkloadScheduleBehavior) DeepCopy() *WorkloadScheduleBehavior {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadScheduleBehavior)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadScheduleList) DeepCopyInto(out *WorkloadScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkloadSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadScheduleList.
+func (in *WorkloadScheduleList) DeepCopy() *WorkloadScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadScheduleSpec) DeepCopyInto(out *WorkloadScheduleSpec) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Schedule.DeepCopyInto(&out.Schedule)
+	in.Behavior.DeepCopyInto(&out.Behavior)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadScheduleSpec.
+func (in *WorkloadScheduleSpec) DeepCopy() *WorkloadScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadScheduleStatus) DeepCopyInto(out *WorkloadScheduleStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.WindowEnd != nil {
+		in, out := &in.WindowEnd, &out.WindowEnd
+		*out = (*in).DeepCopy()
+	}
+	if in.NextTransition != nil {
+		in, out := &in.NextTransition, &out.NextTransition
+		*out = (*in).DeepCopy()
+	}
+	if in.PreWarm != nil {
+		in, out := &in.PreWarm, &out.PreWarm
+		*out = new(PreWarmStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(DrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ramp != nil {
+		in, out := &in.Ramp, &out.Ramp
+		*out = new(RampStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ScaleHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Savings != nil {
+		in, out := &in.Savings, &out.Savings
+		*out = new(SavingsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PausedApplication != nil {
+		in, out := &in.PausedApplication, &out.PausedApplication
+		*out = new(ApplicationReference)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadScheduleStatus.
+func (in *WorkloadScheduleStatus) DeepCopy() *WorkloadScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadScheduleWindows) DeepCopyInto(out *WorkloadScheduleWindows) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]ScheduleWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(ScheduleTemplateReference)
+		**out = **in
+	}
+	if in.PreWarm != nil {
+		in, out := &in.PreWarm, &out.PreWarm
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadScheduleWindows.
+func (in *WorkloadScheduleWindows) DeepCopy() *WorkloadScheduleWindows {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadScheduleWindows)
+	in.DeepCopyInto(out)
+	return out
+}
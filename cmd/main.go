@@ -20,15 +20,21 @@ import (
 	"crypto/tls"
 	"flag"
 	"os"
+	"slices"
+	"strings"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -36,8 +42,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	infrav1beta1 "github.com/vmovahed/workload-schedule-operator/api/v1beta1"
 	"github.com/vmovahed/workload-schedule-operator/internal/controller"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
 	webhookv1 "github.com/vmovahed/workload-schedule-operator/internal/webhook/v1"
+	webhookinfrav1alpha1 "github.com/vmovahed/workload-schedule-operator/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -50,6 +59,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(infrav1alpha1.AddToScheme(scheme))
+	utilruntime.Must(infrav1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -62,6 +72,9 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var pricingConfigMap string
+	var dryRun bool
+	var watchNamespaces string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -80,6 +93,14 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&dryRun, "dry-run", false,
+		"If set, schedules only report what they would do in their status and Events and never scale their targets.")
+	flag.StringVar(&watchNamespaces, "watch-namespaces", "",
+		"A comma-separated list of namespaces the operator is limited to, so it can run with namespaced Roles "+
+			"(see config/namespaced). Leave empty to watch all namespaces.")
+	flag.StringVar(&pricingConfigMap, "pricing-configmap", "",
+		"The <namespace>/<name> of a ConfigMap with cpuCoreHour, memoryGiBHour and currency keys used to "+
+			"estimate the savings of each schedule. Leave empty to only report avoided resources.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -155,8 +176,26 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
+	var namespaces []string
+	var cacheOptions cache.Options
+	var clientOptions client.Options
+	if watchNamespaces != "" {
+		cacheOptions.DefaultNamespaces = make(map[string]cache.Config)
+		for _, namespace := range strings.Split(watchNamespaces, ",") {
+			if namespace = strings.TrimSpace(namespace); namespace != "" {
+				namespaces = append(namespaces, namespace)
+				cacheOptions.DefaultNamespaces[namespace] = cache.Config{}
+			}
+		}
+		// Namespaces are cluster-scoped and cannot be listed with namespaced Roles, so they are read
+		// directly; a Role still grants get on the namespace it is bound in
+		clientOptions.Cache = &client.CacheOptions{DisableFor: []client.Object{&corev1.Namespace{}}}
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
+		Cache:                  cacheOptions,
+		Client:                 clientOptions,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
@@ -179,13 +218,51 @@ func main() {
 		os.Exit(1)
 	}
 
+	var pricing types.NamespacedName
+	if pricingConfigMap != "" {
+		namespace, name, ok := strings.Cut(pricingConfigMap, "/")
+		if !ok || namespace == "" || name == "" {
+			setupLog.Error(nil, "--pricing-configmap must be <namespace>/<name>", "value", pricingConfigMap)
+			os.Exit(1)
+		}
+		pricing = types.NamespacedName{Namespace: namespace, Name: name}
+		if len(namespaces) > 0 && !slices.Contains(namespaces, namespace) {
+			setupLog.Error(nil, "--pricing-configmap must be in one of the --watch-namespaces", "value", pricingConfigMap)
+			os.Exit(1)
+		}
+	}
+
+	// The controllers and webhooks share the WorkloadSchedule indexes, so they are registered once here
+	if err := index.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to set up field indexes")
+		os.Exit(1)
+	}
+
 	if err := (&controller.WorkloadScheduleReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorderFor("workloadschedule-controller"),
+		DryRun:           dryRun,
+		PricingConfigMap: pricing,
+		WatchNamespaces:  namespaces,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "WorkloadSchedule")
 		os.Exit(1)
 	}
+	// Cluster schedules select namespaces by label, which needs cluster-wide access
+	if len(namespaces) == 0 {
+		if err := (&controller.ClusterWorkloadScheduleReconciler{
+			Client:   mgr.GetClient(),
+			Scheme:   mgr.GetScheme(),
+			Recorder: mgr.GetEventRecorderFor("clusterworkloadschedule-controller"),
+			DryRun:   dryRun,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ClusterWorkloadSchedule")
+			os.Exit(1)
+		}
+	} else {
+		setupLog.Info("ClusterWorkloadSchedules are not reconciled with --watch-namespaces")
+	}
 	// nolint:goconst
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
 		if err := webhookv1.SetupPodWebhookWithManager(mgr); err != nil {
@@ -193,6 +270,51 @@ func main() {
 			os.Exit(1)
 		}
 	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		// POD_NAMESPACE and SERVICE_ACCOUNT_NAME come from the downward API in config/manager
+		var controllerUsername string
+		if namespace, serviceAccount := os.Getenv("POD_NAMESPACE"), os.Getenv("SERVICE_ACCOUNT_NAME"); namespace != "" &&
+			serviceAccount != "" {
+			controllerUsername = webhookv1.ServiceAccountUsername(namespace, serviceAccount)
+		} else {
+			setupLog.Info("POD_NAMESPACE or SERVICE_ACCOUNT_NAME is not set; " +
+				"the Deployment webhook may block the controller's own scale-ups")
+		}
+		if err := webhookv1.SetupDeploymentWebhookWithManager(mgr, dryRun, controllerUsername); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Deployment")
+			os.Exit(1)
+		}
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		// Also serves /convert: v1alpha1 is the conversion hub and v1beta1 is registered in the scheme
+		if err := webhookinfrav1alpha1.SetupWorkloadScheduleWebhookWithManager(mgr, namespaces); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "WorkloadSchedule")
+			os.Exit(1)
+		}
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookinfrav1alpha1.SetupClusterWorkloadScheduleWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ClusterWorkloadSchedule")
+			os.Exit(1)
+		}
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookinfrav1alpha1.SetupScheduleTemplateWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ScheduleTemplate")
+			os.Exit(1)
+		}
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookinfrav1alpha1.SetupClusterScheduleTemplateWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ClusterScheduleTemplate")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// breakGlassHolds reports whether a break-glass override of the deployment holds off scaling it
+// down, and keeps the BreakGlass condition in step with the annotation. An override lasts
+// infrav1alpha1.BreakGlassDuration from the first reconcile that sees it; after that the annotation is spent
+// until it is given a new reason.
+func (r *WorkloadScheduleReconciler) breakGlassHolds(ws *infrav1alpha1.WorkloadSchedule, deployment *appsv1.Deployment,
+	now time.Time) bool {
+	reason := deployment.Annotations[infrav1alpha1.BreakGlassAnnotation]
+	if reason == "" || ws.Spec.ScalePolicy != infrav1alpha1.ScalePolicyEnforce {
+		meta.RemoveStatusCondition(&ws.Status.Conditions, infrav1alpha1.ConditionTypeBreakGlass)
+		return false
+	}
+
+	condition := meta.FindStatusCondition(ws.Status.Conditions, infrav1alpha1.ConditionTypeBreakGlass)
+	if condition == nil || condition.Message != reason {
+		// A new reason is a new override, so its hold starts now
+		meta.RemoveStatusCondition(&ws.Status.Conditions, infrav1alpha1.ConditionTypeBreakGlass)
+		meta.SetStatusCondition(&ws.Status.Conditions, metav1.Condition{
+			Type:               infrav1alpha1.ConditionTypeBreakGlass,
+			Status:             metav1.ConditionTrue,
+			Reason:             "Holding",
+			Message:            reason,
+			LastTransitionTime: metav1.NewTime(now),
+		})
+		return true
+	}
+	if condition.Status != metav1.ConditionTrue {
+		return false
+	}
+	if now.Before(condition.LastTransitionTime.Add(infrav1alpha1.BreakGlassDuration)) {
+		return true
+	}
+
+	meta.SetStatusCondition(&ws.Status.Conditions, metav1.Condition{
+		Type:               infrav1alpha1.ConditionTypeBreakGlass,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Expired",
+		Message:            reason,
+		LastTransitionTime: metav1.NewTime(now),
+	})
+	r.recordEvent(ws, corev1.EventTypeNormal, "BreakGlassExpired",
+		"Break-glass override of %s/%s expired after %s; the schedule scales it again",
+		deployment.Namespace, deployment.Name, infrav1alpha1.BreakGlassDuration)
+	return false
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Break-glass override", func() {
+	var (
+		ctx        context.Context
+		r          *WorkloadScheduleReconciler
+		recorder   *record.FakeRecorder
+		ws         *infrav1alpha1.WorkloadSchedule
+		deployment *appsv1.Deployment
+	)
+
+	replicas := func() int32 {
+		updated := &appsv1.Deployment{}
+		Expect(r.Get(ctx, types.NamespacedName{Namespace: "demo", Name: "glass-deployment"}, updated)).To(Succeed())
+		return *updated.Spec.Replicas
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		raised := int32(3)
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "glass-deployment", Namespace: "demo",
+				Annotations: map[string]string{infrav1alpha1.BreakGlassAnnotation: "incident 42"}},
+			Spec: appsv1.DeploymentSpec{Replicas: &raised},
+		}
+		ws = &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "glass-schedule", Namespace: "demo"},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				TargetNamespace:    "demo",
+				TargetDeployment:   "glass-deployment",
+				ReplicasWhenActive: 3,
+				ScalePolicy:        infrav1alpha1.ScalePolicyEnforce,
+			},
+		}
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		recorder = record.NewFakeRecorder(10)
+		r = &WorkloadScheduleReconciler{
+			Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).Build(),
+			Scheme:   scheme,
+			Recorder: recorder,
+		}
+	})
+
+	It("should hold off scaling down until the override expires", func() {
+		action, current, _, err := r.scaleDeployment(ctx, ws, 0, infrav1alpha1.ScaleTriggerWindowClosed)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(action).To(ContainSubstring("break-glass override"))
+		Expect(current).To(Equal(int32(3)))
+		Expect(replicas()).To(Equal(int32(3)))
+		condition := meta.FindStatusCondition(ws.Status.Conditions, infrav1alpha1.ConditionTypeBreakGlass)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).To(Equal("incident 42"))
+
+		By("scaling down once the override is older than BreakGlassDuration")
+		condition.LastTransitionTime = metav1.NewTime(time.Now().Add(-infrav1alpha1.BreakGlassDuration))
+		_, current, _, err = r.scaleDeployment(ctx, ws, 0, infrav1alpha1.ScaleTriggerWindowClosed)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current).To(BeZero())
+		Expect(replicas()).To(BeZero())
+		Expect(meta.IsStatusConditionFalse(ws.Status.Conditions, infrav1alpha1.ConditionTypeBreakGlass)).To(BeTrue())
+		Expect(<-recorder.Events).To(ContainSubstring("BreakGlassExpired"))
+	})
+
+	It("should start another hold for a new reason only", func() {
+		meta.SetStatusCondition(&ws.Status.Conditions, metav1.Condition{
+			Type: infrav1alpha1.ConditionTypeBreakGlass, Status: metav1.ConditionFalse, Reason: "Expired", Message: "incident 42",
+		})
+		Expect(r.breakGlassHolds(ws, deployment, time.Now())).To(BeFalse())
+
+		deployment.Annotations[infrav1alpha1.BreakGlassAnnotation] = "incident 43"
+		Expect(r.breakGlassHolds(ws, deployment, time.Now())).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(ws.Status.Conditions, infrav1alpha1.ConditionTypeBreakGlass)).To(BeTrue())
+	})
+
+	It("should only apply to enforcing schedules and clear once the annotation is removed", func() {
+		Expect(r.breakGlassHolds(ws, deployment, time.Now())).To(BeTrue())
+
+		delete(deployment.Annotations, infrav1alpha1.BreakGlassAnnotation)
+		Expect(r.breakGlassHolds(ws, deployment, time.Now())).To(BeFalse())
+		Expect(meta.FindStatusCondition(ws.Status.Conditions, infrav1alpha1.ConditionTypeBreakGlass)).To(BeNil())
+
+		deployment.Annotations[infrav1alpha1.BreakGlassAnnotation] = "incident 42"
+		ws.Spec.ScalePolicy = infrav1alpha1.ScalePolicyAllow
+		Expect(r.breakGlassHolds(ws, deployment, time.Now())).To(BeFalse())
+	})
+})
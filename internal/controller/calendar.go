@@ -0,0 +1,137 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+	"time"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// calendarHorizon is how many days ahead the next active period is searched for, so a template whose
+// holidays cover every open day does not search forever
+const calendarHorizon = 400
+
+// calendar describes when a schedule is active: daily windows, limited to some days of the week and
+// skipping holidays. A schedule's own startHour and endHour are a single window opening every day.
+type calendar struct {
+	windows  []infrav1alpha1.ScheduleWindow
+	days     []time.Weekday
+	holidays []string
+}
+
+// period is an active stretch of time [start, end)
+type period struct {
+	start, end time.Time
+}
+
+// dailyCalendar returns the calendar of one window opening every day
+func dailyCalendar(startHour, endHour int) calendar {
+	return calendar{windows: []infrav1alpha1.ScheduleWindow{{StartHour: startHour, EndHour: endHour}}}
+}
+
+// templateCalendar returns the calendar a template describes
+func templateCalendar(spec *infrav1alpha1.ScheduleTemplateSpec) calendar {
+	c := calendar{windows: spec.Windows}
+	for _, day := range spec.Days {
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			if string(day) == weekday.String() {
+				c.days = append(c.days, weekday)
+			}
+		}
+	}
+	for _, holiday := range spec.Holidays {
+		c.holidays = append(c.holidays, holiday.Date)
+	}
+	return c
+}
+
+// opensOn reports whether the windows open on the given day
+func (c calendar) opensOn(day time.Time) bool {
+	if len(c.days) > 0 && !slices.Contains(c.days, day.Weekday()) {
+		return false
+	}
+	return !slices.Contains(c.holidays, day.Format(time.DateOnly))
+}
+
+// periods returns the active periods of the day, in order, with overlapping or adjacent windows
+// merged. Windows that never open are skipped.
+func (c calendar) periods(day time.Time) []period {
+	if !c.opensOn(day) {
+		return nil
+	}
+
+	year, month, date := day.Date()
+	var periods []period
+	for _, window := range c.windows {
+		if window.StartHour >= window.EndHour {
+			continue
+		}
+		// time.Date normalizes an EndHour of 24 to midnight of the following day
+		periods = append(periods, period{
+			start: time.Date(year, month, date, window.StartHour, 0, 0, 0, day.Location()),
+			end:   time.Date(year, month, date, window.EndHour, 0, 0, 0, day.Location()),
+		})
+	}
+	slices.SortFunc(periods, func(a, b period) int { return a.start.Compare(b.start) })
+
+	merged := periods[:0]
+	for _, p := range periods {
+		if last := len(merged) - 1; last >= 0 && !p.start.After(merged[last].end) {
+			if p.end.After(merged[last].end) {
+				merged[last].end = p.end
+			}
+			continue
+		}
+		merged = append(merged, p)
+	}
+	return merged
+}
+
+// nextPeriod returns the first active period that has not ended at currentTime, which is the
+// current one while active. The boolean is false when no window opens within calendarHorizon.
+func (c calendar) nextPeriod(currentTime time.Time) (period, bool) {
+	return c.findPeriod(currentTime, func(p period) bool { return p.end.After(currentTime) })
+}
+
+// findPeriod returns the first active period from the day of currentTime on that matches
+func (c calendar) findPeriod(currentTime time.Time, match func(period) bool) (period, bool) {
+	year, month, date := currentTime.Date()
+	for i := 0; i < calendarHorizon; i++ {
+		day := time.Date(year, month, date+i, 0, 0, 0, 0, currentTime.Location())
+		for _, p := range c.periods(day) {
+			if match(p) {
+				return p, true
+			}
+		}
+	}
+	return period{}, false
+}
+
+// isWithinActiveWindow checks if the current time is within one of the calendar's active periods
+func isWithinActiveWindow(currentTime time.Time, c calendar) bool {
+	p, ok := c.nextPeriod(currentTime)
+	return ok && !p.start.After(currentTime)
+}
+
+// nextWindowStart returns the next time an active period opens after currentTime, in the same
+// location as currentTime. The boolean is false when no window opens within calendarHorizon.
+func nextWindowStart(currentTime time.Time, c calendar) (time.Time, bool) {
+	p, ok := c.findPeriod(currentTime, func(p period) bool { return p.start.After(currentTime) })
+	return p.start, ok
+}
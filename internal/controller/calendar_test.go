@@ -0,0 +1,84 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Calendar", func() {
+	toronto := time.FixedZone("EST", -5*60*60)
+
+	// Weekdays 8-12 and 13-19, with 2025-01-08 (a Wednesday) off
+	officeHours := templateCalendar(&infrav1alpha1.ScheduleTemplateSpec{
+		Timezone: "America/Toronto",
+		Windows:  []infrav1alpha1.ScheduleWindow{{StartHour: 13, EndHour: 19}, {StartHour: 8, EndHour: 12}},
+		Days:     []infrav1alpha1.Weekday{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"},
+		Holidays: []infrav1alpha1.Holiday{{Date: "2025-01-08", Name: "Office move"}},
+	})
+
+	It("should be active within any window on an open day", func() {
+		Expect(isWithinActiveWindow(time.Date(2025, 1, 6, 9, 0, 0, 0, toronto), officeHours)).To(BeTrue())
+		Expect(isWithinActiveWindow(time.Date(2025, 1, 6, 12, 30, 0, 0, toronto), officeHours)).To(BeFalse())
+		Expect(isWithinActiveWindow(time.Date(2025, 1, 6, 18, 59, 0, 0, toronto), officeHours)).To(BeTrue())
+	})
+
+	It("should stay inactive on other days and holidays", func() {
+		Expect(isWithinActiveWindow(time.Date(2025, 1, 4, 9, 0, 0, 0, toronto), officeHours)).To(BeFalse())
+		Expect(isWithinActiveWindow(time.Date(2025, 1, 8, 9, 0, 0, 0, toronto), officeHours)).To(BeFalse())
+	})
+
+	It("should find the next transition past lunch, holidays and weekends", func() {
+		next, ok := nextTransition(time.Date(2025, 1, 6, 12, 0, 0, 0, toronto), officeHours)
+		Expect(ok).To(BeTrue())
+		Expect(next).To(Equal(time.Date(2025, 1, 6, 13, 0, 0, 0, toronto)))
+
+		next, _ = nextTransition(time.Date(2025, 1, 7, 20, 0, 0, 0, toronto), officeHours)
+		Expect(next).To(Equal(time.Date(2025, 1, 9, 8, 0, 0, 0, toronto)))
+
+		end, _ := windowEnd(time.Date(2025, 1, 10, 20, 0, 0, 0, toronto), officeHours)
+		Expect(end).To(Equal(time.Date(2025, 1, 13, 12, 0, 0, 0, toronto)))
+	})
+
+	It("should merge overlapping and adjacent windows", func() {
+		c := calendar{windows: []infrav1alpha1.ScheduleWindow{
+			{StartHour: 9, EndHour: 12}, {StartHour: 12, EndHour: 15}, {StartHour: 10, EndHour: 11},
+		}}
+		end, ok := windowEnd(time.Date(2025, 1, 6, 10, 0, 0, 0, toronto), c)
+		Expect(ok).To(BeTrue())
+		Expect(end).To(Equal(time.Date(2025, 1, 6, 15, 0, 0, 0, toronto)))
+	})
+
+	It("should skip holidays when searching for the next window", func() {
+		c := templateCalendar(&infrav1alpha1.ScheduleTemplateSpec{
+			Windows:  []infrav1alpha1.ScheduleWindow{{StartHour: 9, EndHour: 17}},
+			Days:     []infrav1alpha1.Weekday{"Monday"},
+			Holidays: []infrav1alpha1.Holiday{{Date: "2025-01-06"}, {Date: "2025-01-13"}},
+		})
+		start, ok := nextWindowStart(time.Date(2025, 1, 5, 0, 0, 0, 0, toronto), c)
+		Expect(ok).To(BeTrue())
+		Expect(start).To(Equal(time.Date(2025, 1, 20, 9, 0, 0, 0, toronto)))
+
+		_, ok = nextWindowStart(time.Date(2025, 1, 5, 0, 0, 0, 0, toronto), calendar{})
+		Expect(ok).To(BeFalse())
+	})
+})
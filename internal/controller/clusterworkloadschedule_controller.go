@@ -0,0 +1,595 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+const (
+	// ClusterFinalizerName is the finalizer that restores the deployments a ClusterWorkloadSchedule
+	// holds scaled down before it is deleted
+	ClusterFinalizerName = "clusterworkloadschedule.infra.illumin.com/finalizer"
+
+	// ScaledByIndexField indexes Deployments by the cluster schedule named in their scaled-by annotation
+	ScaledByIndexField = ".metadata.annotations.scaledBy"
+)
+
+// indexByScaledBy is the field indexer for ScaledByIndexField
+func indexByScaledBy(obj client.Object) []string {
+	if name := obj.GetAnnotations()[infrav1alpha1.ScaledByAnnotation]; name != "" {
+		return []string{name}
+	}
+	return nil
+}
+
+// ClusterWorkloadScheduleReconciler reconciles a ClusterWorkloadSchedule object
+type ClusterWorkloadScheduleReconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	HTTPClient *http.Client
+	Recorder   record.EventRecorder
+
+	// DryRun makes every cluster schedule behave as if its mode was DryRun
+	DryRun bool
+}
+
+// selectedDeployment is a deployment matched by a cluster schedule, with the labels of its namespace.
+// The schedule acts on neither a deployment a WorkloadSchedule manages (scheduled) nor one a cluster
+// schedule with precedence selects (preceded).
+type selectedDeployment struct {
+	deployment      *appsv1.Deployment
+	namespaceLabels labels.Set
+	scheduled       bool
+	preceded        bool
+}
+
+// +kubebuilder:rbac:groups=infra.illumin.com,resources=clusterworkloadschedules,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infra.illumin.com,resources=clusterworkloadschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infra.illumin.com,resources=clusterworkloadschedules/finalizers,verbs=update
+
+// Reconcile evaluates the window of a ClusterWorkloadSchedule and scales every selected deployment
+// that no WorkloadSchedule or cluster schedule with precedence manages
+func (r *ClusterWorkloadScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	log.Info("Reconciling ClusterWorkloadSchedule", "name", req.Name)
+
+	cws := &infrav1alpha1.ClusterWorkloadSchedule{}
+	if err := r.Get(ctx, req.NamespacedName, cws); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("ClusterWorkloadSchedule resource not found. Ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to get ClusterWorkloadSchedule")
+		return ctrl.Result{}, err
+	}
+
+	// The deployments the schedule holds scaled down get their replicas back before it goes away
+	if cws.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(cws, ClusterFinalizerName) {
+			controllerutil.AddFinalizer(cws, ClusterFinalizerName)
+			if err := r.Update(ctx, cws); err != nil {
+				log.Error(err, "Failed to add finalizer")
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true}, nil
+		}
+	} else {
+		if controllerutil.ContainsFinalizer(cws, ClusterFinalizerName) {
+			if err := r.releaseDeployments(ctx, cws, nil); err != nil {
+				log.Error(err, "Failed to restore deployments")
+				return ctrl.Result{}, err
+			}
+			controllerutil.RemoveFinalizer(cws, ClusterFinalizerName)
+			if err := r.Update(ctx, cws); err != nil {
+				log.Error(err, "Failed to remove finalizer")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	currentTime, err := fetchCurrentTime(ctx, r.HTTPClient, cws.Spec.Timezone)
+	if err != nil {
+		log.Error(err, "Failed to get current time from World Time API", "timezone", cws.Spec.Timezone)
+		timeAPIErrors.Inc()
+		r.recordEvent(cws, corev1.EventTypeWarning, "TimeSourceFailed",
+			"Failed to get the current time for %s, replicas left unchanged: %v", cws.Spec.Timezone, err)
+		r.setCondition(cws, ConditionTypeSynced, metav1.ConditionFalse, "TimeAPIError", err.Error())
+		if statusErr := r.Status().Update(ctx, cws); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: RequeueInterval}, err
+	}
+	withinActiveWindow := isWithinActiveWindow(currentTime, dailyCalendar(cws.Spec.StartHour, cws.Spec.EndHour))
+
+	selected, err := r.selectDeployments(ctx, cws)
+	if err == nil {
+		selected, err = r.managedDeployments(ctx, cws, selected)
+	}
+	if err != nil {
+		log.Error(err, "Failed to select deployments")
+		r.setCondition(cws, ConditionTypeReady, metav1.ConditionFalse, "SelectionError", err.Error())
+		if statusErr := r.Status().Update(ctx, cws); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: RequeueInterval}, err
+	}
+
+	var scaleErrs []error
+	var dryRunScales []infrav1alpha1.DryRunScale
+	managed := int32(0)
+	for _, target := range selected {
+		var err error
+		switch {
+		case target.preceded:
+			// The cluster schedule with precedence takes over the remembered replicas
+			continue
+		case target.scheduled:
+			// The WorkloadSchedule decides the replicas from now on, so there is nothing to restore
+			if !r.isDryRun(cws) && target.deployment.Annotations[infrav1alpha1.ScaledByAnnotation] == cws.Name {
+				err = r.releaseDeployment(ctx, cws, target.deployment, false)
+			}
+		default:
+			managed++
+			var dryRunScale *infrav1alpha1.DryRunScale
+			dryRunScale, err = r.scaleDeployment(ctx, cws, target.deployment, withinActiveWindow)
+			if dryRunScale != nil {
+				dryRunScales = append(dryRunScales, *dryRunScale)
+			}
+		}
+		if err != nil {
+			scaleErrs = append(scaleErrs, fmt.Errorf("deployment %s/%s: %w",
+				target.deployment.Namespace, target.deployment.Name, err))
+		}
+	}
+	// Deployments that no longer match the selectors get back the replicas the schedule took
+	if !r.isDryRun(cws) {
+		if err := r.releaseDeployments(ctx, cws, selected); err != nil {
+			scaleErrs = append(scaleErrs, err)
+		}
+	}
+
+	now := metav1.Now()
+	cws.Status.CurrentLocalTime = currentTime.Format(time.RFC3339)
+	cws.Status.WithinActiveWindow = withinActiveWindow
+	cws.Status.LastSyncTime = &now
+	cws.Status.SelectedDeployments = int32(len(selected))
+	cws.Status.ManagedDeployments = managed
+	cws.Status.DryRunScales = dryRunScales
+	cws.Status.NextTransition = nil
+	if next, ok := nextTransition(currentTime, dailyCalendar(cws.Spec.StartHour, cws.Spec.EndHour)); ok {
+		nextTime := metav1.NewTime(next)
+		cws.Status.NextTransition = &nextTime
+	}
+
+	scaleErr := errors.Join(scaleErrs...)
+	switch {
+	case scaleErr != nil:
+		log.Error(scaleErr, "Failed to scale some deployments")
+		r.setCondition(cws, ConditionTypeReady, metav1.ConditionFalse, "ScaleError", scaleErr.Error())
+	case r.isDryRun(cws):
+		r.setCondition(cws, ConditionTypeReady, metav1.ConditionTrue, "DryRun",
+			"Successfully reconciled in dry-run mode; the selected deployments are not scaled")
+	default:
+		r.setCondition(cws, ConditionTypeReady, metav1.ConditionTrue, "Reconciled", "Successfully reconciled")
+	}
+	r.setCondition(cws, ConditionTypeSynced, metav1.ConditionTrue, "Synced", "Successfully synced with World Time API")
+
+	if err := r.Status().Update(ctx, cws); err != nil {
+		log.Error(err, "Failed to update ClusterWorkloadSchedule status")
+		return ctrl.Result{}, err
+	}
+	if scaleErr != nil {
+		return ctrl.Result{}, scaleErr
+	}
+
+	log.Info("Successfully reconciled ClusterWorkloadSchedule", "selected", len(selected), "managed", managed)
+
+	requeueAfter := RequeueInterval
+	if next := cws.Status.NextTransition; next != nil {
+		if untilNext := next.Sub(currentTime); untilNext > 0 && untilNext < requeueAfter {
+			requeueAfter = untilNext
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// selectDeployments lists the deployments matching the schedule's namespace and workload selectors
+func (r *ClusterWorkloadScheduleReconciler) selectDeployments(ctx context.Context,
+	cws *infrav1alpha1.ClusterWorkloadSchedule) ([]selectedDeployment, error) {
+	namespaceSelector, workloadSelector, err := clusterScheduleSelectors(cws)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := r.List(ctx, namespaces, client.MatchingLabelsSelector{Selector: namespaceSelector}); err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	var selected []selectedDeployment
+	for _, namespace := range namespaces.Items {
+		deployments := &appsv1.DeploymentList{}
+		if err := r.List(ctx, deployments, client.InNamespace(namespace.Name),
+			client.MatchingLabelsSelector{Selector: workloadSelector}); err != nil {
+			return nil, fmt.Errorf("failed to list deployments in %s: %w", namespace.Name, err)
+		}
+		for i := range deployments.Items {
+			selected = append(selected, selectedDeployment{
+				deployment:      &deployments.Items[i],
+				namespaceLabels: labels.Set(namespace.Labels),
+			})
+		}
+	}
+	return selected, nil
+}
+
+// managedDeployments marks every selected entry that a WorkloadSchedule or a cluster schedule with
+// precedence manages, so the schedule leaves them alone
+func (r *ClusterWorkloadScheduleReconciler) managedDeployments(ctx context.Context,
+	cws *infrav1alpha1.ClusterWorkloadSchedule, selected []selectedDeployment) ([]selectedDeployment, error) {
+	clusterSchedules := &infrav1alpha1.ClusterWorkloadScheduleList{}
+	if err := r.List(ctx, clusterSchedules); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterWorkloadSchedules: %w", err)
+	}
+	var preceding []*infrav1alpha1.ClusterWorkloadSchedule
+	for i := range clusterSchedules.Items {
+		other := &clusterSchedules.Items[i]
+		if other.Name != cws.Name && other.DeletionTimestamp.IsZero() && clusterSchedulePrecedes(other, cws) {
+			preceding = append(preceding, other)
+		}
+	}
+
+	for i := range selected {
+		deployment := selected[i].deployment
+		scheduled, err := r.hasWorkloadSchedule(ctx, deployment)
+		if err != nil {
+			return nil, err
+		}
+		if scheduled {
+			selected[i].scheduled = true
+			continue
+		}
+		for _, other := range preceding {
+			if clusterScheduleSelects(other, selected[i].namespaceLabels, deployment) {
+				selected[i].preceded = true
+				break
+			}
+		}
+	}
+	return selected, nil
+}
+
+// hasWorkloadSchedule reports whether a WorkloadSchedule permitted to target the deployment exists,
+// in which case it takes precedence over every cluster schedule
+func (r *ClusterWorkloadScheduleReconciler) hasWorkloadSchedule(ctx context.Context, deployment *appsv1.Deployment) (bool, error) {
+	schedules := &infrav1alpha1.WorkloadScheduleList{}
+	if err := r.List(ctx, schedules, client.MatchingFields{
+		index.TargetField: index.TargetKey(deployment.Namespace, deployment.Name),
+	}); err != nil {
+		return false, fmt.Errorf("failed to list schedules for target: %w", err)
+	}
+
+	var grants []infrav1alpha1.ScheduleTargetGrant
+	for i := range schedules.Items {
+		ws := &schedules.Items[i]
+		if !ws.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if ws.Namespace != deployment.Namespace && grants == nil {
+			var err error
+			if grants, err = namespaceGrants(ctx, r, deployment.Namespace); err != nil {
+				return false, err
+			}
+		}
+		if infrav1alpha1.TargetPermitted(ws, grants) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// scaleDeployment scales one selected deployment for the window. Replicas are remembered in an
+// annotation when the deployment is scaled down and restored from it when the window opens, unless
+// the schedule sets ReplicasWhenActive. A deployment the schedule never scaled down is left as it is
+// while the window is open. In dry-run mode the scale that would be applied is returned instead.
+func (r *ClusterWorkloadScheduleReconciler) scaleDeployment(ctx context.Context, cws *infrav1alpha1.ClusterWorkloadSchedule,
+	deployment *appsv1.Deployment, withinActiveWindow bool) (*infrav1alpha1.DryRunScale, error) {
+	currentReplicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		currentReplicas = *deployment.Spec.Replicas
+	}
+	original, remembered := deployment.Annotations[infrav1alpha1.OriginalReplicasAnnotation]
+	// Replicas remembered by another cluster schedule, such as one that lost precedence, are taken over
+	adopt := remembered && deployment.Annotations[infrav1alpha1.ScaledByAnnotation] != cws.Name
+
+	desiredReplicas := int32(0)
+	if withinActiveWindow {
+		switch {
+		case cws.Spec.ReplicasWhenActive != nil:
+			desiredReplicas = *cws.Spec.ReplicasWhenActive
+		case remembered:
+			parsed, err := parseOriginalReplicas(original)
+			if err != nil {
+				return nil, err
+			}
+			desiredReplicas = parsed
+		default:
+			return nil, nil
+		}
+	}
+	if desiredReplicas == currentReplicas && (!withinActiveWindow || !remembered) && !adopt {
+		return nil, nil
+	}
+
+	if r.isDryRun(cws) {
+		if desiredReplicas == currentReplicas {
+			return nil, nil
+		}
+		dryRunScale := &infrav1alpha1.DryRunScale{Namespace: deployment.Namespace, Name: deployment.Name,
+			FromReplicas: currentReplicas, ToReplicas: desiredReplicas}
+		// Only announce a decision once rather than on every reconcile
+		if !slices.Contains(cws.Status.DryRunScales, *dryRunScale) {
+			r.recordDeploymentEvent(deployment, corev1.EventTypeNormal, "WouldScale",
+				"ClusterWorkloadSchedule %s would scale from %d to %d (dry run)", cws.Name, currentReplicas, desiredReplicas)
+		}
+		return dryRunScale, nil
+	}
+
+	// The replicas to restore are written before scaling down, so they survive a failed scale
+	if !withinActiveWindow && (!remembered || adopt) {
+		patch := client.MergeFrom(deployment.DeepCopy())
+		if deployment.Annotations == nil {
+			deployment.Annotations = make(map[string]string)
+		}
+		if !remembered {
+			deployment.Annotations[infrav1alpha1.OriginalReplicasAnnotation] = strconv.Itoa(int(currentReplicas))
+		}
+		deployment.Annotations[infrav1alpha1.ScaledByAnnotation] = cws.Name
+		if err := r.Patch(ctx, deployment, patch, client.FieldOwner(infrav1alpha1.FieldManager)); err != nil {
+			return nil, fmt.Errorf("failed to record original replicas: %w", err)
+		}
+	}
+
+	if desiredReplicas != currentReplicas {
+		if err := r.scaleTo(ctx, cws, deployment, currentReplicas, desiredReplicas); err != nil {
+			return nil, err
+		}
+	}
+
+	// Once restored, the deployment is back in its owner's hands
+	if withinActiveWindow && remembered {
+		if err := r.forgetOriginalReplicas(ctx, deployment); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// releaseDeployments restores the deployments the schedule holds scaled down that are not among the
+// selected ones, which is all of them when the schedule is being deleted
+func (r *ClusterWorkloadScheduleReconciler) releaseDeployments(ctx context.Context,
+	cws *infrav1alpha1.ClusterWorkloadSchedule, selected []selectedDeployment) error {
+	stillSelected := make(map[types.NamespacedName]bool, len(selected))
+	for _, target := range selected {
+		stillSelected[client.ObjectKeyFromObject(target.deployment)] = true
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, client.MatchingFields{ScaledByIndexField: cws.Name}); err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+	var errs []error
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if stillSelected[client.ObjectKeyFromObject(deployment)] {
+			continue
+		}
+		// A WorkloadSchedule may have taken over the deployment in the meantime
+		scheduled, err := r.hasWorkloadSchedule(ctx, deployment)
+		if err == nil {
+			err = r.releaseDeployment(ctx, cws, deployment, !scheduled)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("deployment %s/%s: %w", deployment.Namespace, deployment.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// releaseDeployment hands a deployment the schedule holds scaled down back to its owner, restoring
+// the remembered replicas when restore is set
+func (r *ClusterWorkloadScheduleReconciler) releaseDeployment(ctx context.Context, cws *infrav1alpha1.ClusterWorkloadSchedule,
+	deployment *appsv1.Deployment, restore bool) error {
+	if original, remembered := deployment.Annotations[infrav1alpha1.OriginalReplicasAnnotation]; remembered && restore {
+		originalReplicas, err := parseOriginalReplicas(original)
+		if err != nil {
+			return err
+		}
+		currentReplicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			currentReplicas = *deployment.Spec.Replicas
+		}
+		if originalReplicas != currentReplicas {
+			if err := r.scaleTo(ctx, cws, deployment, currentReplicas, originalReplicas); err != nil {
+				return err
+			}
+		}
+	}
+	return r.forgetOriginalReplicas(ctx, deployment)
+}
+
+// scaleTo changes the replicas of a deployment through its scale subresource
+func (r *ClusterWorkloadScheduleReconciler) scaleTo(ctx context.Context, cws *infrav1alpha1.ClusterWorkloadSchedule,
+	deployment *appsv1.Deployment, currentReplicas, desiredReplicas int32) error {
+	logf.FromContext(ctx).Info("Scaling deployment", "namespace", deployment.Namespace, "deployment", deployment.Name,
+		"from", currentReplicas, "to", desiredReplicas)
+	scale := &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{Namespace: deployment.Namespace, Name: deployment.Name,
+			ResourceVersion: deployment.ResourceVersion},
+		Spec: autoscalingv1.ScaleSpec{Replicas: desiredReplicas},
+	}
+	if err := r.SubResource("scale").Update(ctx, deployment, client.WithSubResourceBody(scale),
+		client.FieldOwner(infrav1alpha1.FieldManager)); err != nil {
+		return fmt.Errorf("failed to scale deployment: %w", err)
+	}
+	reason := "ScaledUp"
+	if desiredReplicas < currentReplicas {
+		reason = "ScaledDown"
+	}
+	r.recordDeploymentEvent(deployment, corev1.EventTypeNormal, reason,
+		"ClusterWorkloadSchedule %s scaled from %d to %d", cws.Name, currentReplicas, desiredReplicas)
+	return nil
+}
+
+// forgetOriginalReplicas removes the annotations that mark a deployment as held by a cluster schedule
+func (r *ClusterWorkloadScheduleReconciler) forgetOriginalReplicas(ctx context.Context, deployment *appsv1.Deployment) error {
+	if err := r.Get(ctx, client.ObjectKeyFromObject(deployment), deployment); err != nil {
+		return fmt.Errorf("failed to get deployment: %w", err)
+	}
+	patch := client.MergeFrom(deployment.DeepCopy())
+	delete(deployment.Annotations, infrav1alpha1.OriginalReplicasAnnotation)
+	delete(deployment.Annotations, infrav1alpha1.ScaledByAnnotation)
+	if err := r.Patch(ctx, deployment, patch, client.FieldOwner(infrav1alpha1.FieldManager)); err != nil {
+		return fmt.Errorf("failed to clear original replicas: %w", err)
+	}
+	return nil
+}
+
+// parseOriginalReplicas reads the replicas remembered in the original-replicas annotation
+func parseOriginalReplicas(original string) (int32, error) {
+	parsed, err := strconv.ParseInt(original, 10, 32)
+	if err != nil || parsed < 0 {
+		return 0, fmt.Errorf("invalid %s annotation %q", infrav1alpha1.OriginalReplicasAnnotation, original)
+	}
+	return int32(parsed), nil
+}
+
+// clusterScheduleSelectors converts the schedule's selectors. An empty namespace selector is an
+// error rather than a match for every namespace.
+func clusterScheduleSelectors(cws *infrav1alpha1.ClusterWorkloadSchedule) (labels.Selector, labels.Selector, error) {
+	namespaceSelector, err := metav1.LabelSelectorAsSelector(&cws.Spec.NamespaceSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid namespaceSelector: %w", err)
+	}
+	if namespaceSelector.Empty() {
+		return nil, nil, errors.New("namespaceSelector must not be empty")
+	}
+
+	workloadSelector := labels.Everything()
+	if cws.Spec.WorkloadSelector != nil {
+		if workloadSelector, err = metav1.LabelSelectorAsSelector(cws.Spec.WorkloadSelector); err != nil {
+			return nil, nil, fmt.Errorf("invalid workloadSelector: %w", err)
+		}
+	}
+	return namespaceSelector, workloadSelector, nil
+}
+
+// clusterScheduleSelects reports whether the cluster schedule selects the deployment, given the
+// labels of its namespace. Invalid selectors select nothing.
+func clusterScheduleSelects(cws *infrav1alpha1.ClusterWorkloadSchedule, namespaceLabels labels.Set,
+	deployment *appsv1.Deployment) bool {
+	namespaceSelector, workloadSelector, err := clusterScheduleSelectors(cws)
+	if err != nil {
+		return false
+	}
+	return namespaceSelector.Matches(namespaceLabels) && workloadSelector.Matches(labels.Set(deployment.Labels))
+}
+
+// clusterSchedulePrecedes reports whether a takes precedence over b for a deployment both select:
+// higher priority first, then name order
+func clusterSchedulePrecedes(a, b *infrav1alpha1.ClusterWorkloadSchedule) bool {
+	if a.Spec.Priority != b.Spec.Priority {
+		return a.Spec.Priority > b.Spec.Priority
+	}
+	return a.Name < b.Name
+}
+
+// isDryRun reports whether decisions for the cluster schedule must not be applied
+func (r *ClusterWorkloadScheduleReconciler) isDryRun(cws *infrav1alpha1.ClusterWorkloadSchedule) bool {
+	return r.DryRun || cws.Spec.Mode == infrav1alpha1.ScheduleModeDryRun
+}
+
+// recordEvent emits an Event on the ClusterWorkloadSchedule when a recorder is configured
+func (r *ClusterWorkloadScheduleReconciler) recordEvent(cws *infrav1alpha1.ClusterWorkloadSchedule,
+	eventType, reason, messageFmt string, args ...interface{}) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Eventf(cws, eventType, reason, messageFmt, args...)
+}
+
+// recordDeploymentEvent emits an Event on a selected deployment. Cluster schedules act on many
+// deployments, so their decisions are recorded where each deployment's owners look for them.
+func (r *ClusterWorkloadScheduleReconciler) recordDeploymentEvent(deployment *appsv1.Deployment,
+	eventType, reason, messageFmt string, args ...interface{}) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Eventf(deployment, eventType, reason, messageFmt, args...)
+}
+
+// setCondition sets a condition on the ClusterWorkloadSchedule status
+func (r *ClusterWorkloadScheduleReconciler) setCondition(cws *infrav1alpha1.ClusterWorkloadSchedule,
+	conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&cws.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+}
+
+// SetupWithManager sets up the controller with the Manager. It relies on the shared index.TargetField
+// index, which must already be registered, and new deployments or namespaces are picked up on the
+// next periodic reconcile.
+func (r *ClusterWorkloadScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &appsv1.Deployment{},
+		ScaledByIndexField, indexByScaledBy); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.ClusterWorkloadSchedule{}).
+		Named("clusterworkloadschedule").
+		Complete(r)
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+// fixedTimeTransport answers every World Time API request with the same time
+type fixedTimeTransport struct {
+	now time.Time
+}
+
+func (t fixedTimeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := fmt.Sprintf(`{"datetime":%q}`, t.now.Format(time.RFC3339))
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)),
+		Header: http.Header{}, Request: req}, nil
+}
+
+var _ = Describe("ClusterWorkloadSchedule Controller", func() {
+	var (
+		ctx      context.Context
+		cws      *infrav1alpha1.ClusterWorkloadSchedule
+		objects  []client.Object
+		recorder *record.FakeRecorder
+	)
+
+	night := time.Date(2025, 1, 6, 22, 0, 0, 0, time.UTC)
+	morning := time.Date(2025, 1, 7, 9, 0, 0, 0, time.UTC)
+
+	newDeployment := func(namespace, name string, replicas int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: map[string]string{"tier": "web"}},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		}
+	}
+
+	newReconciler := func(now time.Time) *ClusterWorkloadScheduleReconciler {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		return &ClusterWorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objects, cws)...).
+				WithStatusSubresource(cws).
+				WithIndex(&infrav1alpha1.WorkloadSchedule{}, index.TargetField, index.ByTarget).
+				WithIndex(&appsv1.Deployment{}, ScaledByIndexField, indexByScaledBy).Build(),
+			Scheme:     scheme,
+			HTTPClient: &http.Client{Transport: fixedTimeTransport{now: now}},
+			Recorder:   recorder,
+		}
+	}
+
+	replicasOf := func(r *ClusterWorkloadScheduleReconciler, namespace, name string) *appsv1.Deployment {
+		deployment := &appsv1.Deployment{}
+		Expect(r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, deployment)).To(Succeed())
+		return deployment
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = record.NewFakeRecorder(20)
+		cws = &infrav1alpha1.ClusterWorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev-office-hours", Finalizers: []string{ClusterFinalizerName}},
+			Spec: infrav1alpha1.ClusterWorkloadScheduleSpec{
+				Timezone:          "UTC",
+				StartHour:         8,
+				EndHour:           20,
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+				WorkloadSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}},
+			},
+		}
+		objects = []client.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev-a", Labels: map[string]string{"env": "dev"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}},
+			newDeployment("dev-a", "web", 3),
+			newDeployment("dev-a", "scheduled", 2),
+			newDeployment("prod", "web", 4),
+			&infrav1alpha1.WorkloadSchedule{
+				ObjectMeta: metav1.ObjectMeta{Name: "own", Namespace: "dev-a"},
+				Spec:       infrav1alpha1.WorkloadScheduleSpec{TargetNamespace: "dev-a", TargetDeployment: "scheduled"},
+			},
+		}
+	})
+
+	It("should scale selected deployments down and restore them when the window opens", func() {
+		r := newReconciler(night)
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: cws.Name}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueInterval))
+
+		web := replicasOf(r, "dev-a", "web")
+		Expect(*web.Spec.Replicas).To(Equal(int32(0)))
+		Expect(web.Annotations).To(HaveKeyWithValue(infrav1alpha1.OriginalReplicasAnnotation, "3"))
+		Expect(web.Annotations).To(HaveKeyWithValue(infrav1alpha1.ScaledByAnnotation, cws.Name))
+		Expect(<-recorder.Events).To(ContainSubstring("ScaledDown"))
+
+		By("leaving unselected deployments and those with a WorkloadSchedule alone")
+		Expect(*replicasOf(r, "prod", "web").Spec.Replicas).To(Equal(int32(4)))
+		Expect(*replicasOf(r, "dev-a", "scheduled").Spec.Replicas).To(Equal(int32(2)))
+
+		updated := &infrav1alpha1.ClusterWorkloadSchedule{}
+		Expect(r.Get(ctx, types.NamespacedName{Name: cws.Name}, updated)).To(Succeed())
+		Expect(updated.Status.WithinActiveWindow).To(BeFalse())
+		Expect(updated.Status.SelectedDeployments).To(Equal(int32(2)))
+		Expect(updated.Status.ManagedDeployments).To(Equal(int32(1)))
+		Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, ConditionTypeReady)).To(BeTrue())
+
+		By("restoring the original replicas in the morning")
+		r.HTTPClient = &http.Client{Transport: fixedTimeTransport{now: morning}}
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: cws.Name}})
+		Expect(err).NotTo(HaveOccurred())
+		web = replicasOf(r, "dev-a", "web")
+		Expect(*web.Spec.Replicas).To(Equal(int32(3)))
+		Expect(web.Annotations).NotTo(HaveKey(infrav1alpha1.OriginalReplicasAnnotation))
+		Expect(web.Annotations).NotTo(HaveKey(infrav1alpha1.ScaledByAnnotation))
+	})
+
+	It("should restore the deployments it holds scaled down when it is deleted", func() {
+		r := newReconciler(night)
+		key := types.NamespacedName{Name: cws.Name}
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*replicasOf(r, "dev-a", "web").Spec.Replicas).To(BeZero())
+
+		Expect(r.Delete(ctx, cws)).To(Succeed())
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		web := replicasOf(r, "dev-a", "web")
+		Expect(*web.Spec.Replicas).To(Equal(int32(3)))
+		Expect(web.Annotations).NotTo(HaveKey(infrav1alpha1.OriginalReplicasAnnotation))
+		Expect(web.Annotations).NotTo(HaveKey(infrav1alpha1.ScaledByAnnotation))
+		err = r.Get(ctx, key, &infrav1alpha1.ClusterWorkloadSchedule{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should restore deployments that drop out of its selection", func() {
+		held := newDeployment("prod", "batch", 0)
+		held.Annotations = map[string]string{
+			infrav1alpha1.OriginalReplicasAnnotation: "2",
+			infrav1alpha1.ScaledByAnnotation:         "prod-office-hours",
+		}
+		objects = append(objects, held)
+		r := newReconciler(night)
+		key := types.NamespacedName{Name: cws.Name}
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*replicasOf(r, "dev-a", "web").Spec.Replicas).To(BeZero())
+
+		updated := &infrav1alpha1.ClusterWorkloadSchedule{}
+		Expect(r.Get(ctx, key, updated)).To(Succeed())
+		updated.Spec.WorkloadSelector.MatchLabels = map[string]string{"tier": "worker"}
+		Expect(r.Update(ctx, updated)).To(Succeed())
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		web := replicasOf(r, "dev-a", "web")
+		Expect(*web.Spec.Replicas).To(Equal(int32(3)))
+		Expect(web.Annotations).NotTo(HaveKey(infrav1alpha1.OriginalReplicasAnnotation))
+
+		By("leaving deployments another cluster schedule holds alone")
+		held = replicasOf(r, "prod", "batch")
+		Expect(*held.Spec.Replicas).To(BeZero())
+		Expect(held.Annotations).To(HaveKeyWithValue(infrav1alpha1.ScaledByAnnotation, "prod-office-hours"))
+	})
+
+	It("should leave deployments it never scaled down alone while active", func() {
+		r := newReconciler(morning)
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: cws.Name}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*replicasOf(r, "dev-a", "web").Spec.Replicas).To(Equal(int32(3)))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should only report what it would do in dry-run mode", func() {
+		cws.Spec.Mode = infrav1alpha1.ScheduleModeDryRun
+		r := newReconciler(night)
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: cws.Name}})
+		Expect(err).NotTo(HaveOccurred())
+
+		web := replicasOf(r, "dev-a", "web")
+		Expect(*web.Spec.Replicas).To(Equal(int32(3)))
+		Expect(web.Annotations).NotTo(HaveKey(infrav1alpha1.OriginalReplicasAnnotation))
+		Expect(<-recorder.Events).To(ContainSubstring("WouldScale"))
+
+		updated := &infrav1alpha1.ClusterWorkloadSchedule{}
+		Expect(r.Get(ctx, types.NamespacedName{Name: cws.Name}, updated)).To(Succeed())
+		Expect(updated.Status.DryRunScales).To(ConsistOf(infrav1alpha1.DryRunScale{
+			Namespace: "dev-a", Name: "web", FromReplicas: 3, ToReplicas: 0}))
+
+		By("announcing each decision only once")
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: cws.Name}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should yield to a cluster schedule with a higher priority", func() {
+		objects = append(objects, &infrav1alpha1.ClusterWorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a-override"},
+			Spec: infrav1alpha1.ClusterWorkloadScheduleSpec{
+				Priority:          10,
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+			},
+		})
+		r := newReconciler(night)
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: cws.Name}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*replicasOf(r, "dev-a", "web").Spec.Replicas).To(Equal(int32(3)))
+
+		updated := &infrav1alpha1.ClusterWorkloadSchedule{}
+		Expect(r.Get(ctx, types.NamespacedName{Name: cws.Name}, updated)).To(Succeed())
+		Expect(updated.Status.ManagedDeployments).To(BeZero())
+	})
+})
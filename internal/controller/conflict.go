@@ -0,0 +1,112 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+// ConditionTypeConflicted is the condition type reporting other schedules with the same target
+const ConditionTypeConflicted = "Conflicted"
+
+// resolveTargetConflict finds every schedule targeting the same deployment as ws and returns
+// the one that should act along with the others, ordered by precedence. Schedules that are
+// being deleted, or that are not permitted to target the deployment, no longer compete for it.
+func (r *WorkloadScheduleReconciler) resolveTargetConflict(ctx context.Context,
+	ws *infrav1alpha1.WorkloadSchedule) (*infrav1alpha1.WorkloadSchedule, []*infrav1alpha1.WorkloadSchedule, error) {
+	schedules := &infrav1alpha1.WorkloadScheduleList{}
+	if err := r.List(ctx, schedules, client.MatchingFields{
+		index.TargetField: index.TargetKey(ws.Spec.TargetNamespace, ws.Spec.TargetDeployment),
+	}); err != nil {
+		return nil, nil, fmt.Errorf("failed to list schedules for target: %w", err)
+	}
+
+	// Schedules from other namespaces only compete when a grant lets them act on the target
+	var grants []infrav1alpha1.ScheduleTargetGrant
+	if slices.ContainsFunc(schedules.Items, func(other infrav1alpha1.WorkloadSchedule) bool {
+		return other.Namespace != ws.Spec.TargetNamespace
+	}) {
+		var err error
+		if grants, err = namespaceGrants(ctx, r, ws.Spec.TargetNamespace); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	candidates := []*infrav1alpha1.WorkloadSchedule{ws}
+	for i := range schedules.Items {
+		other := &schedules.Items[i]
+		if other.UID == ws.UID || !other.DeletionTimestamp.IsZero() || !infrav1alpha1.TargetPermitted(other, grants) {
+			continue
+		}
+		candidates = append(candidates, other)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return infrav1alpha1.SchedulePrecedes(candidates[i], candidates[j])
+	})
+	return candidates[0], candidates[1:], nil
+}
+
+// scheduleNames formats schedules as a comma separated list of namespace/name
+func scheduleNames(schedules []*infrav1alpha1.WorkloadSchedule) string {
+	names := make([]string, 0, len(schedules))
+	for _, ws := range schedules {
+		names = append(names, types.NamespacedName{Namespace: ws.Namespace, Name: ws.Name}.String())
+	}
+	return strings.Join(names, ", ")
+}
+
+// findSchedulesForSameTarget maps a WorkloadSchedule to every schedule sharing its target, so that
+// losers take over promptly when the winner is deleted or its priority changes
+func (r *WorkloadScheduleReconciler) findSchedulesForSameTarget(ctx context.Context, obj client.Object) []reconcile.Request {
+	ws, ok := obj.(*infrav1alpha1.WorkloadSchedule)
+	if !ok {
+		return nil
+	}
+
+	schedules := &infrav1alpha1.WorkloadScheduleList{}
+	if err := r.List(ctx, schedules, client.MatchingFields{
+		index.TargetField: index.TargetKey(ws.Spec.TargetNamespace, ws.Spec.TargetDeployment),
+	}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list schedules for target")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(schedules.Items))
+	for i := range schedules.Items {
+		if schedules.Items[i].UID == ws.UID {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: schedules.Items[i].Namespace,
+			Name:      schedules.Items[i].Name,
+		}})
+	}
+	return requests
+}
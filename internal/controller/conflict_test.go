@@ -0,0 +1,142 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+var _ = Describe("Target conflicts", func() {
+	created := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+
+	newSchedule := func(namespace, name string, priority int32, age time.Duration) *infrav1alpha1.WorkloadSchedule {
+		return &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				UID:               types.UID(namespace + "-" + name),
+				CreationTimestamp: metav1.NewTime(created.Add(-age)),
+			},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				TargetNamespace:  "demo",
+				TargetDeployment: "demo-deployment",
+				Priority:         priority,
+			},
+		}
+	}
+
+	newReconciler := func(objs ...client.Object) *WorkloadScheduleReconciler {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		return &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+				WithIndex(&infrav1alpha1.WorkloadSchedule{}, index.TargetField, index.ByTarget).Build(),
+			Scheme: scheme,
+		}
+	}
+
+	It("should let a schedule without competitors act", func() {
+		ws := newSchedule("default", "only", 0, 0)
+		elsewhere := newSchedule("default", "elsewhere", 10, time.Hour)
+		elsewhere.Spec.TargetDeployment = "another-deployment"
+		r := newReconciler(ws, elsewhere)
+
+		winner, others, err := r.resolveTargetConflict(context.Background(), ws)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(winner).To(BeIdenticalTo(ws))
+		Expect(others).To(BeEmpty())
+	})
+
+	grant := func(from ...string) *infrav1alpha1.ScheduleTargetGrant {
+		g := &infrav1alpha1.ScheduleTargetGrant{ObjectMeta: metav1.ObjectMeta{Name: "schedules", Namespace: "demo"}}
+		for _, namespace := range from {
+			g.Spec.From = append(g.Spec.From, infrav1alpha1.ScheduleTargetGrantFrom{Namespace: namespace})
+		}
+		return g
+	}
+
+	It("should prefer the highest priority", func() {
+		ws := newSchedule("team-a", "nightly", 0, 2*time.Hour)
+		preferred := newSchedule("team-b", "release-freeze", 5, 0)
+		r := newReconciler(ws, preferred, grant("team-a", "team-b"))
+
+		winner, others, err := r.resolveTargetConflict(context.Background(), ws)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(winner.Name).To(Equal("release-freeze"))
+		Expect(others).To(HaveLen(1))
+		Expect(others[0]).To(BeIdenticalTo(ws))
+	})
+
+	It("should ignore competitors from namespaces without a grant", func() {
+		ws := newSchedule("demo", "nightly", 0, 0)
+		intruder := newSchedule("team-b", "scale-to-zero", 100, time.Hour)
+		r := newReconciler(ws, intruder, grant("team-a"))
+
+		winner, others, err := r.resolveTargetConflict(context.Background(), ws)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(winner).To(BeIdenticalTo(ws))
+		Expect(others).To(BeEmpty())
+	})
+
+	It("should let the oldest schedule win a priority tie, then namespace/name order", func() {
+		older := newSchedule("team-b", "older", 0, time.Hour)
+		newer := newSchedule("team-a", "newer", 0, 0)
+		Expect(infrav1alpha1.SchedulePrecedes(older, newer)).To(BeTrue())
+		Expect(infrav1alpha1.SchedulePrecedes(newer, older)).To(BeFalse())
+
+		a := newSchedule("team-a", "same-age", 0, 0)
+		b := newSchedule("team-b", "same-age", 0, 0)
+		Expect(infrav1alpha1.SchedulePrecedes(a, b)).To(BeTrue())
+		Expect(infrav1alpha1.SchedulePrecedes(b, a)).To(BeFalse())
+	})
+
+	It("should ignore competitors that are being deleted", func() {
+		ws := newSchedule("team-a", "nightly", 0, 0)
+		deleting := newSchedule("team-b", "old", 10, time.Hour)
+		deleting.Finalizers = []string{FinalizerName}
+		deleting.DeletionTimestamp = &metav1.Time{Time: created}
+		r := newReconciler(ws, deleting)
+
+		winner, _, err := r.resolveTargetConflict(context.Background(), ws)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(winner).To(BeIdenticalTo(ws))
+	})
+
+	It("should requeue the other schedules sharing a target", func() {
+		ws := newSchedule("team-a", "nightly", 0, 0)
+		r := newReconciler(ws, newSchedule("team-b", "weekend", 0, 0))
+
+		requests := r.findSchedulesForSameTarget(context.Background(), ws)
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Name).To(Equal("weekend"))
+	})
+})
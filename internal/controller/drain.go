@@ -0,0 +1,238 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+const (
+	// DefaultDrainGracePeriod is the maximum wait for idle pods when DrainGate.GracePeriod is unset
+	DefaultDrainGracePeriod = 5 * time.Minute
+
+	// DrainPollInterval is how often the drain gate is re-evaluated while waiting
+	DrainPollInterval = 15 * time.Second
+
+	// DrainIdleAnnotationValue is the annotation value that marks a pod as idle
+	DrainIdleAnnotationValue = "true"
+)
+
+// drainResult is the outcome of evaluating the drain gate
+type drainResult struct {
+	// proceed is true when replicas may be lowered
+	proceed bool
+	// forced is true when the grace period ran out while pods were still busy
+	forced bool
+	// busyPods is the number of pods that reported in-flight work
+	busyPods int32
+	// waited is how long the controller has been waiting for the pods to become idle
+	waited time.Duration
+}
+
+// evaluateDrainGate checks whether the target's pods are idle so replicas can be lowered.
+// The wait starts on the first call and is tracked in ws.Status.Drain; once the grace period
+// has elapsed the scale-down is allowed even if pods are still busy.
+func (r *WorkloadScheduleReconciler) evaluateDrainGate(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule,
+	deployment *appsv1.Deployment, now time.Time) drainResult {
+	log := logf.FromContext(ctx)
+	gate := ws.Spec.DrainGate
+
+	if ws.Status.Drain == nil {
+		ws.Status.Drain = &infrav1alpha1.DrainStatus{StartTime: metav1.NewTime(now)}
+	}
+
+	busy, err := r.countBusyPods(ctx, gate, deployment)
+	if err != nil {
+		// Unable to tell, so rely on the grace period to bound the wait
+		log.Error(err, "Failed to evaluate drain gate", "deployment", deployment.Name)
+		busy = max(busy, 1)
+	}
+	ws.Status.Drain.BusyPods = busy
+
+	result := drainResult{busyPods: busy, waited: now.Sub(ws.Status.Drain.StartTime.Time)}
+	if busy == 0 {
+		result.proceed = true
+		return result
+	}
+
+	gracePeriod := DefaultDrainGracePeriod
+	if gate.GracePeriod != nil {
+		gracePeriod = gate.GracePeriod.Duration
+	}
+	if result.waited >= gracePeriod {
+		result.proceed = true
+		result.forced = true
+	}
+	return result
+}
+
+// countBusyPods returns the number of running pods of the deployment that report in-flight work
+func (r *WorkloadScheduleReconciler) countBusyPods(ctx context.Context, gate *infrav1alpha1.DrainGate,
+	deployment *appsv1.Deployment) (int32, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return 0, fmt.Errorf("invalid deployment selector: %w", err)
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(deployment.Namespace),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return 0, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	busy := int32(0)
+	var lastErr error
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1.PodRunning || !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		idle, err := r.isPodIdle(ctx, gate, pod)
+		if err != nil {
+			lastErr = fmt.Errorf("pod %s: %w", pod.Name, err)
+		}
+		if !idle {
+			busy++
+		}
+	}
+	return busy, lastErr
+}
+
+// isPodIdle evaluates the drain gate for a single pod. Probe failures count as busy.
+func (r *WorkloadScheduleReconciler) isPodIdle(ctx context.Context, gate *infrav1alpha1.DrainGate, pod *corev1.Pod) (bool, error) {
+	switch {
+	case gate.Annotation != "":
+		return pod.Annotations[gate.Annotation] == DrainIdleAnnotationValue, nil
+
+	case gate.HTTPGet != nil:
+		scheme := "http"
+		if gate.HTTPGet.Scheme == "HTTPS" {
+			scheme = "https"
+		}
+		resp, err := r.getFromPod(ctx, pod, scheme, gate.HTTPGet.Port, gate.HTTPGet.Path)
+		if err != nil {
+			return false, err
+		}
+		defer resp.Body.Close()
+		return resp.StatusCode >= 200 && resp.StatusCode < 300, nil
+
+	case gate.Metric != nil:
+		path := gate.Metric.Path
+		if path == "" {
+			path = "/metrics"
+		}
+		resp, err := r.getFromPod(ctx, pod, "http", gate.Metric.Port, path)
+		if err != nil {
+			return false, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return false, fmt.Errorf("metrics endpoint returned status %d", resp.StatusCode)
+		}
+		value, err := sumMetric(resp.Body, gate.Metric.Name)
+		if err != nil {
+			return false, err
+		}
+		return value == 0, nil
+	}
+
+	return false, fmt.Errorf("drain gate has no httpGet, metric or annotation configured")
+}
+
+// getFromPod issues a GET request against the pod IP
+func (r *WorkloadScheduleReconciler) getFromPod(ctx context.Context, pod *corev1.Pod, scheme string,
+	port int32, path string) (*http.Response, error) {
+	if pod.Status.PodIP == "" {
+		return nil, fmt.Errorf("pod has no IP")
+	}
+
+	httpClient := r.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	url := fmt.Sprintf("%s://%s%s", scheme, net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(port))), path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	return httpClient.Do(req)
+}
+
+// sumMetric adds up every sample of the named metric in a Prometheus text exposition
+func sumMetric(body io.Reader, name string) (float64, error) {
+	found := false
+	total := 0.0
+
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || !strings.HasPrefix(line, name) {
+			continue
+		}
+
+		// Skip metrics that merely share the prefix, e.g. "requests_in_flight_total"
+		rest := line[len(name):]
+		if rest == "" {
+			continue
+		}
+		if rest[0] == '{' {
+			end := strings.LastIndex(rest, "}")
+			if end < 0 {
+				return 0, fmt.Errorf("malformed sample %q", line)
+			}
+			rest = rest[end+1:]
+		} else if rest[0] != ' ' && rest[0] != '\t' {
+			continue
+		}
+
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			return 0, fmt.Errorf("malformed sample %q", line)
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse sample %q: %w", line, err)
+		}
+		total += value
+		found = true
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read metrics: %w", err)
+	}
+	if !found {
+		return 0, fmt.Errorf("metric %s not found", name)
+	}
+	return total, nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Drain gate", func() {
+	var (
+		ctx        context.Context
+		deployment *appsv1.Deployment
+		now        time.Time
+	)
+
+	newPod := func(name string, annotations map[string]string, ip string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "demo",
+				Labels:      map[string]string{"app": "demo"},
+				Annotations: annotations,
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: ip},
+		}
+	}
+
+	newReconciler := func(objs ...runtime.Object) *WorkloadScheduleReconciler {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		return &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+			Scheme: scheme,
+		}
+	}
+
+	newSchedule := func(gate *infrav1alpha1.DrainGate) *infrav1alpha1.WorkloadSchedule {
+		return &infrav1alpha1.WorkloadSchedule{
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				TargetNamespace:  "demo",
+				TargetDeployment: "demo-deployment",
+				DrainGate:        gate,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2025, 1, 6, 17, 0, 0, 0, time.UTC)
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo-deployment", Namespace: "demo"},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "demo"}},
+			},
+		}
+	})
+
+	It("should wait while annotated pods are busy and proceed once they are idle", func() {
+		gate := &infrav1alpha1.DrainGate{Annotation: "example.com/idle"}
+		busyPod := newPod("busy", map[string]string{"example.com/idle": "false"}, "")
+		r := newReconciler(deployment, newPod("idle", map[string]string{"example.com/idle": "true"}, ""), busyPod)
+		ws := newSchedule(gate)
+
+		result := r.evaluateDrainGate(ctx, ws, deployment, now)
+		Expect(result.proceed).To(BeFalse())
+		Expect(result.busyPods).To(Equal(int32(1)))
+		Expect(ws.Status.Drain).NotTo(BeNil())
+		Expect(ws.Status.Drain.BusyPods).To(Equal(int32(1)))
+
+		busyPod.Annotations["example.com/idle"] = "true"
+		Expect(r.Update(ctx, busyPod)).To(Succeed())
+
+		result = r.evaluateDrainGate(ctx, ws, deployment, now.Add(time.Minute))
+		Expect(result.proceed).To(BeTrue())
+		Expect(result.forced).To(BeFalse())
+		Expect(result.waited).To(Equal(time.Minute))
+	})
+
+	It("should force the scale-down once the grace period expires", func() {
+		gate := &infrav1alpha1.DrainGate{
+			Annotation:  "example.com/idle",
+			GracePeriod: &metav1.Duration{Duration: 2 * time.Minute},
+		}
+		r := newReconciler(deployment, newPod("busy", nil, ""))
+		ws := newSchedule(gate)
+
+		Expect(r.evaluateDrainGate(ctx, ws, deployment, now).proceed).To(BeFalse())
+
+		result := r.evaluateDrainGate(ctx, ws, deployment, now.Add(2*time.Minute))
+		Expect(result.proceed).To(BeTrue())
+		Expect(result.forced).To(BeTrue())
+	})
+
+	It("should probe the HTTP endpoint and the metric exposed by the pods", func() {
+		inFlight := 3
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/idle":
+				if inFlight > 0 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}
+			case "/metrics":
+				_, _ = fmt.Fprintf(w, "# TYPE http_requests_in_flight gauge\n"+
+					"http_requests_in_flight{handler=\"a\"} %d\nhttp_requests_in_flight{handler=\"b\"} 0\n"+
+					"http_requests_in_flight_total 42\n", inFlight)
+			}
+		}))
+		defer server.Close()
+
+		host, portStr, err := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+		Expect(err).NotTo(HaveOccurred())
+		port, err := strconv.Atoi(portStr)
+		Expect(err).NotTo(HaveOccurred())
+
+		r := newReconciler(deployment, newPod("web", nil, host))
+		gates := []*infrav1alpha1.DrainGate{
+			{HTTPGet: &infrav1alpha1.DrainHTTPGetAction{Path: "/idle", Port: int32(port)}},
+			{Metric: &infrav1alpha1.DrainMetric{Name: "http_requests_in_flight", Port: int32(port)}},
+		}
+
+		for _, gate := range gates {
+			inFlight = 3
+			busy, err := r.countBusyPods(ctx, gate, deployment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(busy).To(Equal(int32(1)))
+
+			inFlight = 0
+			busy, err = r.countBusyPods(ctx, gate, deployment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(busy).To(BeZero())
+		}
+	})
+
+	It("should report a missing metric as an error", func() {
+		_, err := sumMetric(strings.NewReader("other_metric 1\n"), "http_requests_in_flight")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,108 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Dry run", func() {
+	var (
+		ctx      context.Context
+		r        *WorkloadScheduleReconciler
+		recorder *record.FakeRecorder
+		ws       *infrav1alpha1.WorkloadSchedule
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		replicas := int32(0)
+		recorder = record.NewFakeRecorder(10)
+		r = &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "dry-run-deployment", Namespace: "demo"},
+				Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			}).Build(),
+			Scheme:   scheme,
+			Recorder: recorder,
+		}
+		ws = &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "dry-run-schedule", Namespace: "default"},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				TargetNamespace:    "demo",
+				TargetDeployment:   "dry-run-deployment",
+				ReplicasWhenActive: 4,
+				Mode:               infrav1alpha1.ScheduleModeDryRun,
+				RampStrategy:       &infrav1alpha1.RampStrategy{Interval: &metav1.Duration{Duration: time.Minute}},
+			},
+		}
+	})
+
+	expectUnchanged := func() {
+		deployment := &appsv1.Deployment{}
+		Expect(r.Get(ctx, types.NamespacedName{Namespace: "demo", Name: "dry-run-deployment"}, deployment)).To(Succeed())
+		Expect(*deployment.Spec.Replicas).To(BeZero())
+	}
+
+	It("should report the decision without scaling the target", func() {
+		action, replicas, wait, err := r.scaleDeployment(ctx, ws, 4, infrav1alpha1.ScaleTriggerWindowOpened)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(action).To(Equal("dry run, would scale from 0 to 4"))
+		Expect(replicas).To(BeZero())
+		Expect(wait).To(BeZero())
+		Expect(ws.Status.Ramp).To(BeNil())
+		Expect(ws.Status.History).To(BeEmpty())
+		expectUnchanged()
+
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(<-recorder.Events).To(Equal(
+			"Normal WouldScale Dry run: would scale deployment demo/dry-run-deployment from 0 to 4 (WindowOpened)"))
+		<-recorder.Events
+
+		By("not repeating the Event for the same decision")
+		ws.Status.LastScaleAction = action
+		_, _, _, err = r.scaleDeployment(ctx, ws, 4, infrav1alpha1.ScaleTriggerDrift)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should apply the manager-wide flag to every schedule", func() {
+		ws.Spec.Mode = infrav1alpha1.ScheduleModeActive
+		r.DryRun = true
+
+		action, _, _, err := r.scaleDeployment(ctx, ws, 4, infrav1alpha1.ScaleTriggerWindowOpened)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(action).To(HavePrefix("dry run"))
+		expectUnchanged()
+	})
+})
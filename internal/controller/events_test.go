@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Events", func() {
+	var (
+		ctx      context.Context
+		recorder *record.FakeRecorder
+		ws       *infrav1alpha1.WorkloadSchedule
+	)
+
+	newReconciler := func(objs ...runtime.Object) *WorkloadScheduleReconciler {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		return &WorkloadScheduleReconciler{
+			Client:   fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+			Scheme:   scheme,
+			Recorder: recorder,
+		}
+	}
+
+	newDeployment := func(replicas int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo-deployment", Namespace: "demo"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = record.NewFakeRecorder(10)
+		ws = &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "business-hours", Namespace: "default"},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				Timezone:           "America/Toronto",
+				TargetNamespace:    "demo",
+				TargetDeployment:   "demo-deployment",
+				ReplicasWhenActive: 3,
+			},
+		}
+	})
+
+	It("should emit ScaledUp and ScaledDown on the schedule and the deployment", func() {
+		r := newReconciler(newDeployment(0))
+
+		_, _, _, err := r.scaleDeployment(ctx, ws, 3, infrav1alpha1.ScaleTriggerWindowOpened)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(<-recorder.Events).To(Equal("Normal ScaledUp Scaled deployment demo/demo-deployment up from 0 to 3 (target 3)"))
+		Expect(<-recorder.Events).To(ContainSubstring("ScaledUp"))
+
+		_, _, _, err = r.scaleDeployment(ctx, ws, 0, infrav1alpha1.ScaleTriggerWindowClosed)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(<-recorder.Events).To(ContainSubstring("ScaledDown"))
+
+		By("staying quiet when no change is needed")
+		<-recorder.Events
+		_, _, _, err = r.scaleDeployment(ctx, ws, 0, infrav1alpha1.ScaleTriggerWindowClosed)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should emit TargetNotFound when the deployment is missing", func() {
+		r := newReconciler()
+
+		_, _, _, err := r.scaleDeployment(ctx, ws, 3, infrav1alpha1.ScaleTriggerWindowOpened)
+		Expect(err).To(HaveOccurred())
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(ContainSubstring("Warning TargetNotFound"))
+	})
+
+	It("should emit TimeSourceFailed on the deployment only when it exists", func() {
+		r := newReconciler(newDeployment(1))
+		r.recordTargetEvent(ctx, ws, nil, corev1.EventTypeWarning, "TimeSourceFailed", "failed: %v", errors.New("timeout"))
+		Expect(recorder.Events).To(HaveLen(2))
+
+		<-recorder.Events
+		<-recorder.Events
+		r = newReconciler()
+		r.recordTargetEvent(ctx, ws, nil, corev1.EventTypeWarning, "TimeSourceFailed", "failed: %v", errors.New("timeout"))
+		Expect(recorder.Events).To(HaveLen(1))
+	})
+})
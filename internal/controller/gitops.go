@@ -0,0 +1,203 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+const (
+	// DesiredReplicasAnnotation carries the desired replicas on the target deployment with the GitOps
+	// actuation method
+	DesiredReplicasAnnotation = "schedule.illumin.com/desired-replicas"
+
+	// ArgoCDSkipReconcileAnnotation makes Argo CD stop reconciling an Application while it is "true"
+	ArgoCDSkipReconcileAnnotation = "argocd.argoproj.io/skip-reconcile"
+
+	// ArgoCDPausedByAnnotation records which schedule ("<namespace>/<name>") paused an Application,
+	// so a pause set by someone else is never lifted
+	ArgoCDPausedByAnnotation = "schedule.illumin.com/paused-by"
+
+	// DefaultArgoCDNamespace is where Applications are looked up when the reference has no namespace
+	DefaultArgoCDNamespace = infrav1alpha1.DefaultApplicationNamespace
+)
+
+// argoCDApplicationGVK is the kind of Argo CD Applications, which are handled as unstructured objects
+// so the operator does not depend on Argo CD's API
+var argoCDApplicationGVK = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Application"}
+
+// +kubebuilder:rbac:groups=argoproj.io,resources=applications,verbs=get;patch
+
+// actuationMethod returns how the schedule's decisions are applied, Scale unless set otherwise
+func actuationMethod(ws *infrav1alpha1.WorkloadSchedule) infrav1alpha1.ActuationMethod {
+	if ws.Spec.Actuation == nil || ws.Spec.Actuation.Method == "" {
+		return infrav1alpha1.ActuationMethodScale
+	}
+	return ws.Spec.Actuation.Method
+}
+
+// publishesTo reports whether the GitOps method writes the desired replicas to the given target
+func publishesTo(ws *infrav1alpha1.WorkloadSchedule, target infrav1alpha1.PublishTarget) bool {
+	if actuationMethod(ws) != infrav1alpha1.ActuationMethodGitOps {
+		return false
+	}
+	if len(ws.Spec.Actuation.Publish) == 0 {
+		return target == infrav1alpha1.PublishTargetAnnotation
+	}
+	return slices.Contains(ws.Spec.Actuation.Publish, target)
+}
+
+// publishDesiredReplicas is the GitOps counterpart of scaling: the replicas of the deployment are left
+// alone and the desired count is written where a GitOps tool can pick it up. The state ConfigMap is
+// written after the status update, from status.desiredReplicas.
+func (r *WorkloadScheduleReconciler) publishDesiredReplicas(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule,
+	deployment *appsv1.Deployment, currentReplicas, desiredReplicas int32) (string, error) {
+	value := strconv.Itoa(int(desiredReplicas))
+	if publishesTo(ws, infrav1alpha1.PublishTargetAnnotation) && deployment.Annotations[DesiredReplicasAnnotation] != value {
+		patch := client.MergeFrom(deployment.DeepCopy())
+		if deployment.Annotations == nil {
+			deployment.Annotations = make(map[string]string)
+		}
+		deployment.Annotations[DesiredReplicasAnnotation] = value
+		if err := r.Patch(ctx, deployment, patch, client.FieldOwner(infrav1alpha1.FieldManager)); err != nil {
+			return "publish failed", fmt.Errorf("failed to annotate deployment: %w", err)
+		}
+	}
+
+	if ws.Status.DesiredReplicas != desiredReplicas {
+		r.recordTargetEvent(ctx, ws, deployment, corev1.EventTypeNormal, "DesiredReplicasPublished",
+			"Published %d desired replicas for deployment %s/%s, which has %d", desiredReplicas,
+			deployment.Namespace, deployment.Name, currentReplicas)
+	}
+	return fmt.Sprintf("published desired replicas %d (replicas=%d)", desiredReplicas, currentReplicas), nil
+}
+
+// argoCDApplicationNamespace returns the namespace of the referenced Application
+func argoCDApplicationNamespace(ref *infrav1alpha1.ApplicationReference) string {
+	return ref.ApplicationNamespace()
+}
+
+// ReasonArgoCDApplicationNotGranted is the Ready reason while a schedule references an Argo CD
+// Application in another namespace that no ScheduleTargetGrant lets it pause
+const ReasonArgoCDApplicationNotGranted = "ArgoCDApplicationNotGranted"
+
+// applicationPermitted reports whether the schedule may pause its Argo CD Application. Only
+// Applications in another namespace look up its grants.
+func (r *WorkloadScheduleReconciler) applicationPermitted(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule) (bool, error) {
+	namespace := argoCDApplicationNamespace(ws.Spec.Actuation.ArgoCDApplication)
+	if ws.Namespace == namespace {
+		return true, nil
+	}
+	grants, err := namespaceGrants(ctx, r, namespace)
+	if err != nil {
+		return false, err
+	}
+	return infrav1alpha1.ApplicationPermitted(ws, grants), nil
+}
+
+// syncArgoCDPause pauses the schedule's Argo CD Application while paused is true and lifts the pause
+// otherwise. A pause the schedule set on a previously referenced Application is lifted first.
+func (r *WorkloadScheduleReconciler) syncArgoCDPause(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule, paused bool) error {
+	ref := ws.Spec.Actuation.ArgoCDApplication
+	if previous := ws.Status.PausedApplication; previous != nil &&
+		argoCDApplicationKey(previous) != argoCDApplicationKey(ref) {
+		if err := r.releaseArgoCDPause(ctx, ws); err != nil {
+			return err
+		}
+	}
+	if err := r.setArgoCDPause(ctx, ws, ref, paused); err != nil {
+		return err
+	}
+	ws.Status.PausedApplication = nil
+	if paused {
+		ws.Status.PausedApplication = &infrav1alpha1.ApplicationReference{Namespace: argoCDApplicationNamespace(ref), Name: ref.Name}
+	}
+	return nil
+}
+
+// releaseArgoCDPause lifts the pause the schedule set on an Argo CD Application, for when the
+// reference is removed, the schedule switches to a dry run or it stands down. An Application that
+// no longer exists has nothing to lift.
+func (r *WorkloadScheduleReconciler) releaseArgoCDPause(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule) error {
+	ref := ws.Status.PausedApplication
+	if ref == nil {
+		return nil
+	}
+	if err := r.setArgoCDPause(ctx, ws, ref, false); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return err
+	}
+	ws.Status.PausedApplication = nil
+	return nil
+}
+
+// argoCDApplicationKey returns the namespaced name of the referenced Application
+func argoCDApplicationKey(ref *infrav1alpha1.ApplicationReference) types.NamespacedName {
+	return types.NamespacedName{Namespace: argoCDApplicationNamespace(ref), Name: ref.Name}
+}
+
+// setArgoCDPause pauses the Application or lifts the pause. Only a pause this schedule set is lifted.
+func (r *WorkloadScheduleReconciler) setArgoCDPause(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule,
+	ref *infrav1alpha1.ApplicationReference, paused bool) error {
+	key := argoCDApplicationKey(ref)
+
+	app := &unstructured.Unstructured{}
+	app.SetGroupVersionKind(argoCDApplicationGVK)
+	if err := r.Get(ctx, key, app); err != nil {
+		return fmt.Errorf("failed to get Argo CD Application %s: %w", key, err)
+	}
+
+	owner := types.NamespacedName{Namespace: ws.Namespace, Name: ws.Name}.String()
+	annotations := app.GetAnnotations()
+	skipped, pausedBy := annotations[ArgoCDSkipReconcileAnnotation] == "true", annotations[ArgoCDPausedByAnnotation]
+	if paused == skipped || (!paused && pausedBy != owner) {
+		return nil
+	}
+
+	patch := client.MergeFrom(app.DeepCopy())
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	reason, message := "ArgoCDPaused", "Paused Argo CD Application %s while the schedule is inactive"
+	if paused {
+		annotations[ArgoCDSkipReconcileAnnotation] = "true"
+		annotations[ArgoCDPausedByAnnotation] = owner
+	} else {
+		delete(annotations, ArgoCDSkipReconcileAnnotation)
+		delete(annotations, ArgoCDPausedByAnnotation)
+		reason, message = "ArgoCDResumed", "Resumed Argo CD Application %s"
+	}
+	app.SetAnnotations(annotations)
+	if err := r.Patch(ctx, app, patch, client.FieldOwner(infrav1alpha1.FieldManager)); err != nil {
+		return fmt.Errorf("failed to update Argo CD Application %s: %w", key, err)
+	}
+	r.recordEvent(ws, corev1.EventTypeNormal, reason, message, key)
+	return nil
+}
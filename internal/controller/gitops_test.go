@@ -0,0 +1,222 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("GitOps actuation", func() {
+	var (
+		ctx      context.Context
+		r        *WorkloadScheduleReconciler
+		recorder *record.FakeRecorder
+		ws       *infrav1alpha1.WorkloadSchedule
+	)
+
+	newReconciler := func(objs ...client.Object) {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		scheme.AddKnownTypeWithName(argoCDApplicationGVK, &unstructured.Unstructured{})
+		replicas := int32(0)
+		recorder = record.NewFakeRecorder(10)
+		r = &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "gitops-deployment", Namespace: "demo"},
+				Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			})...).WithStatusSubresource(&infrav1alpha1.WorkloadSchedule{}).Build(),
+			Scheme:   scheme,
+			Recorder: recorder,
+		}
+	}
+
+	newApplication := func(annotations map[string]string) *unstructured.Unstructured {
+		app := &unstructured.Unstructured{}
+		app.SetGroupVersionKind(argoCDApplicationGVK)
+		app.SetNamespace(DefaultArgoCDNamespace)
+		app.SetName("demo")
+		app.SetAnnotations(annotations)
+		return app
+	}
+
+	getApplication := func() map[string]string {
+		app := newApplication(nil)
+		Expect(r.Get(ctx, types.NamespacedName{Namespace: DefaultArgoCDNamespace, Name: "demo"}, app)).To(Succeed())
+		return app.GetAnnotations()
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ws = &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "gitops-schedule", Namespace: "default"},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				Timezone:           "UTC",
+				TargetNamespace:    "demo",
+				TargetDeployment:   "gitops-deployment",
+				ReplicasWhenActive: 3,
+				Actuation:          &infrav1alpha1.Actuation{Method: infrav1alpha1.ActuationMethodGitOps},
+			},
+		}
+	})
+
+	It("should publish the desired replicas instead of scaling", func() {
+		newReconciler()
+
+		action, replicas, _, err := r.scaleDeployment(ctx, ws, 3, infrav1alpha1.ScaleTriggerWindowOpened)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(action).To(Equal("published desired replicas 3 (replicas=0)"))
+		Expect(replicas).To(BeZero())
+		Expect(ws.Status.History).To(BeEmpty())
+		Expect(<-recorder.Events).To(ContainSubstring("DesiredReplicasPublished"))
+
+		deployment := &appsv1.Deployment{}
+		Expect(r.Get(ctx, types.NamespacedName{Namespace: "demo", Name: "gitops-deployment"}, deployment)).To(Succeed())
+		Expect(*deployment.Spec.Replicas).To(BeZero())
+		Expect(deployment.Annotations).To(HaveKeyWithValue(DesiredReplicasAnnotation, "3"))
+	})
+
+	It("should add the desired replicas to the state ConfigMap when asked to", func() {
+		newReconciler()
+		ws.Status.DesiredReplicas = 3
+		Expect(r.stateConfigMapData(ws, time.UTC)).NotTo(HaveKey(StateKeyDesiredReplicas))
+
+		ws.Spec.Actuation.Publish = []infrav1alpha1.PublishTarget{infrav1alpha1.PublishTargetConfigMap}
+		Expect(r.stateConfigMapData(ws, time.UTC)).To(HaveKeyWithValue(StateKeyDesiredReplicas, "3"))
+		Expect(publishesTo(ws, infrav1alpha1.PublishTargetAnnotation)).To(BeFalse())
+
+		ws.Spec.Actuation.Method = infrav1alpha1.ActuationMethodScale
+		Expect(r.stateConfigMapData(ws, time.UTC)).NotTo(HaveKey(StateKeyDesiredReplicas))
+	})
+
+	It("should not publish the desired replicas to the state ConfigMap in a dry run", func() {
+		newReconciler()
+		ws.Status.DesiredReplicas = 3
+		ws.Spec.Mode = infrav1alpha1.ScheduleModeDryRun
+		ws.Spec.Actuation.Publish = []infrav1alpha1.PublishTarget{infrav1alpha1.PublishTargetConfigMap}
+
+		Expect(r.syncStateConfigMap(ctx, ws, time.UTC)).To(Succeed())
+		configMap := &corev1.ConfigMap{}
+		Expect(r.Get(ctx, types.NamespacedName{Namespace: "demo",
+			Name: infrav1alpha1.StateConfigMapName("gitops-deployment")}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(HaveKey(StateKeyActive))
+		Expect(configMap.Data).NotTo(HaveKey(StateKeyDesiredReplicas))
+
+		By("honouring the manager-wide dry run as well")
+		ws.Spec.Mode = ""
+		r.DryRun = true
+		Expect(r.stateConfigMapData(ws, time.UTC)).NotTo(HaveKey(StateKeyDesiredReplicas))
+	})
+
+	It("should pause the Argo CD Application while inactive and resume it afterwards", func() {
+		newReconciler(newApplication(nil))
+		ws.Spec.Actuation = &infrav1alpha1.Actuation{
+			ArgoCDApplication: &infrav1alpha1.ApplicationReference{Name: "demo"},
+		}
+
+		Expect(r.syncArgoCDPause(ctx, ws, true)).To(Succeed())
+		Expect(getApplication()).To(And(
+			HaveKeyWithValue(ArgoCDSkipReconcileAnnotation, "true"),
+			HaveKeyWithValue(ArgoCDPausedByAnnotation, "default/gitops-schedule"),
+		))
+		Expect(<-recorder.Events).To(ContainSubstring("ArgoCDPaused"))
+
+		Expect(r.syncArgoCDPause(ctx, ws, false)).To(Succeed())
+		Expect(getApplication()).NotTo(Or(HaveKey(ArgoCDSkipReconcileAnnotation), HaveKey(ArgoCDPausedByAnnotation)))
+		Expect(<-recorder.Events).To(ContainSubstring("ArgoCDResumed"))
+	})
+
+	It("should not lift a pause it did not set", func() {
+		newReconciler(newApplication(map[string]string{ArgoCDSkipReconcileAnnotation: "true"}))
+		ws.Spec.Actuation = &infrav1alpha1.Actuation{
+			ArgoCDApplication: &infrav1alpha1.ApplicationReference{Name: "demo"},
+		}
+
+		Expect(r.syncArgoCDPause(ctx, ws, true)).To(Succeed())
+		Expect(r.syncArgoCDPause(ctx, ws, false)).To(Succeed())
+		Expect(getApplication()).To(HaveKeyWithValue(ArgoCDSkipReconcileAnnotation, "true"))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should only pause an Application in another namespace with a grant naming it", func() {
+		grant := &infrav1alpha1.ScheduleTargetGrant{
+			ObjectMeta: metav1.ObjectMeta{Name: "schedules", Namespace: DefaultArgoCDNamespace},
+			Spec: infrav1alpha1.ScheduleTargetGrantSpec{
+				From: []infrav1alpha1.ScheduleTargetGrantFrom{{Namespace: "default"}},
+			},
+		}
+		newReconciler(grant)
+		ws.Spec.Actuation = &infrav1alpha1.Actuation{
+			ArgoCDApplication: &infrav1alpha1.ApplicationReference{Name: "demo"},
+		}
+
+		By("not counting a grant for every deployment as one for the Application")
+		permitted, err := r.applicationPermitted(ctx, ws)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(permitted).To(BeFalse())
+
+		grant.Spec.To = []infrav1alpha1.ScheduleTargetGrantTo{{Kind: infrav1alpha1.GrantTargetKindApplication, Name: "demo"}}
+		Expect(r.Update(ctx, grant)).To(Succeed())
+		permitted, err = r.applicationPermitted(ctx, ws)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(permitted).To(BeTrue())
+		Expect(grant.Permits("default", "demo")).To(BeFalse())
+	})
+
+	It("should lift its pause once the reference is removed or the schedule stands down", func() {
+		newReconciler(newApplication(nil))
+		ws.Spec.Actuation = &infrav1alpha1.Actuation{
+			ArgoCDApplication: &infrav1alpha1.ApplicationReference{Name: "demo"},
+		}
+		Expect(r.Create(ctx, ws)).To(Succeed())
+
+		Expect(r.syncArgoCDPause(ctx, ws, true)).To(Succeed())
+		Expect(ws.Status.PausedApplication).To(Equal(&infrav1alpha1.ApplicationReference{
+			Namespace: DefaultArgoCDNamespace, Name: "demo"}))
+		ws.Spec.Actuation = nil
+		Expect(r.releaseArgoCDPause(ctx, ws)).To(Succeed())
+		Expect(getApplication()).NotTo(HaveKey(ArgoCDSkipReconcileAnnotation))
+		Expect(ws.Status.PausedApplication).To(BeNil())
+
+		By("lifting it when the schedule stands down")
+		ws.Spec.Actuation = &infrav1alpha1.Actuation{
+			ArgoCDApplication: &infrav1alpha1.ApplicationReference{Name: "demo"},
+		}
+		Expect(r.syncArgoCDPause(ctx, ws, true)).To(Succeed())
+		_, err := r.standDown(ctx, ws, ReasonTargetNotGranted, "No grant for the target")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getApplication()).NotTo(HaveKey(ArgoCDSkipReconcileAnnotation))
+		Expect(ws.Status.PausedApplication).To(BeNil())
+	})
+})
@@ -0,0 +1,80 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+// ReasonTargetNotGranted is the Ready reason while a schedule targets another namespace that has no
+// ScheduleTargetGrant for it
+const ReasonTargetNotGranted = "TargetNotGranted"
+
+// +kubebuilder:rbac:groups=infra.illumin.com,resources=scheduletargetgrants,verbs=get;list;watch
+
+// namespaceGrants lists the ScheduleTargetGrants of a target namespace
+func namespaceGrants(ctx context.Context, c client.Reader, namespace string) ([]infrav1alpha1.ScheduleTargetGrant, error) {
+	grants := &infrav1alpha1.ScheduleTargetGrantList{}
+	if err := c.List(ctx, grants, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ScheduleTargetGrants: %w", err)
+	}
+	return grants.Items, nil
+}
+
+// targetPermitted reports whether the schedule may act on its target. Only schedules targeting
+// another namespace look up its grants.
+func (r *WorkloadScheduleReconciler) targetPermitted(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule) (bool, error) {
+	if ws.Namespace == ws.Spec.TargetNamespace {
+		return true, nil
+	}
+	grants, err := namespaceGrants(ctx, r, ws.Spec.TargetNamespace)
+	if err != nil {
+		return false, err
+	}
+	return infrav1alpha1.TargetPermitted(ws, grants), nil
+}
+
+// findSchedulesForGrant maps a ScheduleTargetGrant to the schedules in other namespaces that target
+// its namespace or pause an Argo CD Application there, so granting or revoking access takes effect
+// without waiting for the next requeue
+func (r *WorkloadScheduleReconciler) findSchedulesForGrant(ctx context.Context, obj client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, field := range []string{index.TargetNamespaceField, index.ApplicationNamespaceField} {
+		schedules := &infrav1alpha1.WorkloadScheduleList{}
+		if err := r.List(ctx, schedules, client.MatchingFields{field: obj.GetNamespace()}); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to list schedules for grant", "field", field)
+			return nil
+		}
+		for _, ws := range schedules.Items {
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ws.Namespace, Name: ws.Name}}
+			if ws.Namespace != obj.GetNamespace() && !slices.Contains(requests, request) {
+				requests = append(requests, request)
+			}
+		}
+	}
+	return requests
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+var _ = Describe("Target grants", func() {
+	var (
+		ctx      context.Context
+		r        *WorkloadScheduleReconciler
+		recorder *record.FakeRecorder
+		key      types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		ws := &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "intruder", Namespace: "team-b", Finalizers: []string{FinalizerName}},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				Timezone:           "UTC",
+				TargetNamespace:    "demo",
+				TargetDeployment:   "demo-deployment",
+				ReplicasWhenActive: 1,
+			},
+		}
+		key = types.NamespacedName{Namespace: "team-b", Name: "intruder"}
+		recorder = record.NewFakeRecorder(10)
+		r = &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(ws, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "demo"}}).
+				WithStatusSubresource(ws).
+				WithIndex(&infrav1alpha1.WorkloadSchedule{}, index.TargetField, index.ByTarget).
+				WithIndex(&infrav1alpha1.WorkloadSchedule{}, index.TargetNamespaceField, index.ByTargetNamespace).
+				WithIndex(&infrav1alpha1.WorkloadSchedule{}, index.ApplicationNamespaceField, index.ByApplicationNamespace).
+				Build(),
+			Scheme:   scheme,
+			Recorder: recorder,
+		}
+	})
+
+	It("should not act on another namespace without a grant", func() {
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueInterval))
+
+		ws := &infrav1alpha1.WorkloadSchedule{}
+		Expect(r.Get(ctx, key, ws)).To(Succeed())
+		ready := meta.FindStatusCondition(ws.Status.Conditions, ConditionTypeReady)
+		Expect(ready).NotTo(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(ReasonTargetNotGranted))
+		Expect(<-recorder.Events).To(ContainSubstring("Warning TargetNotGranted"))
+	})
+
+	It("should honour a grant for the schedule's namespace and deployment", func() {
+		grant := &infrav1alpha1.ScheduleTargetGrant{
+			ObjectMeta: metav1.ObjectMeta{Name: "schedules", Namespace: "demo"},
+			Spec: infrav1alpha1.ScheduleTargetGrantSpec{
+				From: []infrav1alpha1.ScheduleTargetGrantFrom{{Namespace: "team-b"}},
+				To:   []infrav1alpha1.ScheduleTargetGrantTo{{Name: "other-deployment"}},
+			},
+		}
+		Expect(r.Create(ctx, grant)).To(Succeed())
+
+		ws := &infrav1alpha1.WorkloadSchedule{}
+		Expect(r.Get(ctx, key, ws)).To(Succeed())
+		permitted, err := r.targetPermitted(ctx, ws)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(permitted).To(BeFalse())
+
+		grant.Spec.To = append(grant.Spec.To, infrav1alpha1.ScheduleTargetGrantTo{Name: "demo-deployment"})
+		Expect(r.Update(ctx, grant)).To(Succeed())
+		permitted, err = r.targetPermitted(ctx, ws)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(permitted).To(BeTrue())
+
+		By("requeueing the schedules that target the grant's namespace")
+		Expect(r.findSchedulesForGrant(ctx, grant)).To(ConsistOf(reconcile.Request{NamespacedName: key}))
+	})
+
+	It("should requeue schedules pausing an Argo CD Application in the grant's namespace", func() {
+		local := &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "local", Namespace: DefaultArgoCDNamespace},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				Timezone:           "UTC",
+				TargetNamespace:    DefaultArgoCDNamespace,
+				TargetDeployment:   "argocd-server",
+				ReplicasWhenActive: 1,
+			},
+		}
+		pausing := &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "pausing", Namespace: "team-b"},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				Timezone:           "UTC",
+				TargetNamespace:    "team-b",
+				TargetDeployment:   "web",
+				ReplicasWhenActive: 1,
+				Actuation: &infrav1alpha1.Actuation{
+					ArgoCDApplication: &infrav1alpha1.ApplicationReference{Name: "web"},
+				},
+			},
+		}
+		Expect(r.Create(ctx, local)).To(Succeed())
+		Expect(r.Create(ctx, pausing)).To(Succeed())
+
+		grant := &infrav1alpha1.ScheduleTargetGrant{ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: DefaultArgoCDNamespace}}
+		Expect(r.findSchedulesForGrant(ctx, grant)).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "team-b", Name: "pausing"}}))
+	})
+})
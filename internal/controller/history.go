@@ -0,0 +1,52 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// TimeSourceWorldTimeAPI identifies the World Time API as the time source in the scale history
+const TimeSourceWorldTimeAPI = "worldtimeapi.org"
+
+// scaleTrigger tells why the replicas would change on this reconcile. It compares against the
+// status of the previous reconcile, so it must be called before the status is updated.
+func scaleTrigger(ws *infrav1alpha1.WorkloadSchedule, withinActiveWindow, preWarming bool) infrav1alpha1.ScaleTrigger {
+	switch {
+	case preWarming:
+		return infrav1alpha1.ScaleTriggerPreWarm
+	case ws.Status.LastSyncTime == nil || ws.Status.WithinActiveWindow != withinActiveWindow:
+		if withinActiveWindow {
+			return infrav1alpha1.ScaleTriggerWindowOpened
+		}
+		return infrav1alpha1.ScaleTriggerWindowClosed
+	case ws.Status.Ramp != nil:
+		return infrav1alpha1.ScaleTriggerRamp
+	default:
+		return infrav1alpha1.ScaleTriggerDrift
+	}
+}
+
+// recordScaleHistory adds an entry to the front of the history, dropping the oldest entries past
+// MaxScaleHistory
+func recordScaleHistory(ws *infrav1alpha1.WorkloadSchedule, entry infrav1alpha1.ScaleHistoryEntry) {
+	history := append([]infrav1alpha1.ScaleHistoryEntry{entry}, ws.Status.History...)
+	if len(history) > infrav1alpha1.MaxScaleHistory {
+		history = history[:infrav1alpha1.MaxScaleHistory]
+	}
+	ws.Status.History = history
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Scale history", func() {
+	var ws *infrav1alpha1.WorkloadSchedule
+
+	BeforeEach(func() {
+		ws = &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "history-schedule", Namespace: "default"},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				TargetNamespace:    "demo",
+				TargetDeployment:   "history-deployment",
+				ReplicasWhenActive: 3,
+			},
+		}
+	})
+
+	It("should tell what triggered a scale", func() {
+		Expect(scaleTrigger(ws, true, false)).To(Equal(infrav1alpha1.ScaleTriggerWindowOpened))
+		Expect(scaleTrigger(ws, false, true)).To(Equal(infrav1alpha1.ScaleTriggerPreWarm))
+
+		now := metav1.Now()
+		ws.Status.LastSyncTime = &now
+		ws.Status.WithinActiveWindow = true
+		Expect(scaleTrigger(ws, false, false)).To(Equal(infrav1alpha1.ScaleTriggerWindowClosed))
+		Expect(scaleTrigger(ws, true, false)).To(Equal(infrav1alpha1.ScaleTriggerDrift))
+
+		ws.Status.Ramp = &infrav1alpha1.RampStatus{FromReplicas: 0, TargetReplicas: 3}
+		Expect(scaleTrigger(ws, true, false)).To(Equal(infrav1alpha1.ScaleTriggerRamp))
+	})
+
+	It("should record only real changes, newest first", func() {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		replicas := int32(0)
+		r := &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "history-deployment", Namespace: "demo"},
+				Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			}).Build(),
+			Scheme: scheme,
+		}
+		ctx := context.Background()
+
+		_, _, _, err := r.scaleDeployment(ctx, ws, 3, infrav1alpha1.ScaleTriggerWindowOpened)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, _, err = r.scaleDeployment(ctx, ws, 3, infrav1alpha1.ScaleTriggerDrift)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, _, err = r.scaleDeployment(ctx, ws, 0, infrav1alpha1.ScaleTriggerWindowClosed)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ws.Status.History).To(HaveLen(2))
+		latest := ws.Status.History[0]
+		Expect(latest.FromReplicas).To(Equal(int32(3)))
+		Expect(latest.ToReplicas).To(Equal(int32(0)))
+		Expect(latest.Reason).To(Equal("ScaledDown"))
+		Expect(latest.Trigger).To(Equal(infrav1alpha1.ScaleTriggerWindowClosed))
+		Expect(latest.TimeSource).To(Equal(TimeSourceWorldTimeAPI))
+		Expect(ws.Status.History[1].Trigger).To(Equal(infrav1alpha1.ScaleTriggerWindowOpened))
+	})
+
+	It("should keep at most MaxScaleHistory entries", func() {
+		for i := range infrav1alpha1.MaxScaleHistory + 5 {
+			recordScaleHistory(ws, infrav1alpha1.ScaleHistoryEntry{ToReplicas: int32(i)})
+		}
+		Expect(ws.Status.History).To(HaveLen(infrav1alpha1.MaxScaleHistory))
+		Expect(ws.Status.History[0].ToReplicas).To(Equal(int32(infrav1alpha1.MaxScaleHistory + 4)))
+	})
+})
@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var (
+	scheduleActive = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "workloadschedule_active",
+		Help: "Whether the schedule is within its active window (1) or not (0).",
+	}, []string{"namespace", "name"})
+
+	scheduleDesiredReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "workloadschedule_desired_replicas",
+		Help: "Replicas the schedule wants its target deployment to have.",
+	}, []string{"namespace", "name"})
+
+	scheduleCurrentReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "workloadschedule_current_replicas",
+		Help: "Replicas of the target deployment after the last reconcile.",
+	}, []string{"namespace", "name"})
+
+	scheduleScaleActions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "workloadschedule_scale_actions_total",
+		Help: "Number of times the target deployment was scaled, by direction (up or down).",
+	}, []string{"namespace", "name", "direction"})
+
+	scheduleNextTransitionSeconds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "workloadschedule_next_transition_seconds",
+		Help: "Seconds until the schedule next becomes active or inactive.",
+	}, []string{"namespace", "name"})
+
+	scheduleAvoidedReplicaHours = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "workloadschedule_avoided_replica_hours_total",
+		Help: "Replica-hours not run compared to keeping the active replicas around the clock.",
+	}, []string{"namespace", "name"})
+
+	scheduleAvoidedCPUCoreHours = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "workloadschedule_avoided_cpu_core_hours_total",
+		Help: "CPU requests not run, in core-hours.",
+	}, []string{"namespace", "name"})
+
+	scheduleAvoidedMemoryGiBHours = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "workloadschedule_avoided_memory_gib_hours_total",
+		Help: "Memory requests not run, in GiB-hours.",
+	}, []string{"namespace", "name"})
+
+	scheduleEstimatedSavings = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "workloadschedule_estimated_savings_total",
+		Help: "Avoided CPU and memory priced at the configured rates.",
+	}, []string{"namespace", "name", "currency"})
+
+	timeAPIRequestDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "workloadschedule_time_api_request_duration_seconds",
+		Help:    "Latency of requests to the World Time API.",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	})
+
+	timeAPIErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "workloadschedule_time_api_errors_total",
+		Help: "Number of failed requests to the World Time API.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		scheduleActive,
+		scheduleDesiredReplicas,
+		scheduleCurrentReplicas,
+		scheduleScaleActions,
+		scheduleNextTransitionSeconds,
+		scheduleAvoidedReplicaHours,
+		scheduleAvoidedCPUCoreHours,
+		scheduleAvoidedMemoryGiBHours,
+		scheduleEstimatedSavings,
+		timeAPIRequestDuration,
+		timeAPIErrors,
+	)
+}
+
+// recordScheduleMetrics exports the state of a schedule after a successful reconcile
+func recordScheduleMetrics(ws *infrav1alpha1.WorkloadSchedule, desiredReplicas int32, currentTime time.Time) {
+	active := 0.0
+	if ws.Status.WithinActiveWindow {
+		active = 1
+	}
+	scheduleActive.WithLabelValues(ws.Namespace, ws.Name).Set(active)
+	scheduleDesiredReplicas.WithLabelValues(ws.Namespace, ws.Name).Set(float64(desiredReplicas))
+	scheduleCurrentReplicas.WithLabelValues(ws.Namespace, ws.Name).Set(float64(ws.Status.CurrentReplicas))
+
+	if ws.Status.NextTransition != nil {
+		scheduleNextTransitionSeconds.WithLabelValues(ws.Namespace, ws.Name).Set(ws.Status.NextTransition.Sub(currentTime).Seconds())
+	} else {
+		scheduleNextTransitionSeconds.DeleteLabelValues(ws.Namespace, ws.Name)
+	}
+}
+
+// deleteScheduleMetrics drops every series of a deleted schedule so it stops being reported
+func deleteScheduleMetrics(ws *infrav1alpha1.WorkloadSchedule) {
+	labels := prometheus.Labels{"namespace": ws.Namespace, "name": ws.Name}
+	scheduleActive.DeletePartialMatch(labels)
+	scheduleDesiredReplicas.DeletePartialMatch(labels)
+	scheduleCurrentReplicas.DeletePartialMatch(labels)
+	scheduleScaleActions.DeletePartialMatch(labels)
+	scheduleNextTransitionSeconds.DeletePartialMatch(labels)
+	scheduleAvoidedReplicaHours.DeletePartialMatch(labels)
+	scheduleAvoidedCPUCoreHours.DeletePartialMatch(labels)
+	scheduleAvoidedMemoryGiBHours.DeletePartialMatch(labels)
+	scheduleEstimatedSavings.DeletePartialMatch(labels)
+}
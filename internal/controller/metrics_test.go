@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Metrics", func() {
+	var ws *infrav1alpha1.WorkloadSchedule
+
+	BeforeEach(func() {
+		ws = &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "metrics-schedule", Namespace: "default"},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				Timezone:           "America/Toronto",
+				TargetNamespace:    "demo",
+				TargetDeployment:   "metrics-deployment",
+				ReplicasWhenActive: 3,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		deleteScheduleMetrics(ws)
+	})
+
+	It("should export the schedule state and drop it on deletion", func() {
+		now := time.Date(2025, 3, 3, 10, 0, 0, 0, time.UTC)
+		next := metav1.NewTime(now.Add(90 * time.Minute))
+		ws.Status.WithinActiveWindow = true
+		ws.Status.CurrentReplicas = 2
+		ws.Status.NextTransition = &next
+
+		recordScheduleMetrics(ws, 3, now)
+		Expect(testutil.ToFloat64(scheduleActive.WithLabelValues("default", "metrics-schedule"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(scheduleDesiredReplicas.WithLabelValues("default", "metrics-schedule"))).To(Equal(3.0))
+		Expect(testutil.ToFloat64(scheduleCurrentReplicas.WithLabelValues("default", "metrics-schedule"))).To(Equal(2.0))
+		Expect(testutil.ToFloat64(scheduleNextTransitionSeconds.WithLabelValues("default", "metrics-schedule"))).
+			To(Equal(5400.0))
+
+		deleteScheduleMetrics(ws)
+		Expect(testutil.CollectAndCount(scheduleActive, "workloadschedule_active")).To(BeZero())
+		Expect(testutil.CollectAndCount(scheduleNextTransitionSeconds)).To(BeZero())
+	})
+
+	It("should count scale actions by direction", func() {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		replicas := int32(0)
+		r := &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "metrics-deployment", Namespace: "demo"},
+				Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			}).Build(),
+			Scheme:   scheme,
+			Recorder: record.NewFakeRecorder(10),
+		}
+
+		_, _, _, err := r.scaleDeployment(context.Background(), ws, 3, infrav1alpha1.ScaleTriggerWindowOpened)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, _, err = r.scaleDeployment(context.Background(), ws, 0, infrav1alpha1.ScaleTriggerWindowClosed)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, _, err = r.scaleDeployment(context.Background(), ws, 0, infrav1alpha1.ScaleTriggerWindowClosed)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testutil.ToFloat64(scheduleScaleActions.WithLabelValues("default", "metrics-schedule", "up"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(scheduleScaleActions.WithLabelValues("default", "metrics-schedule", "down"))).
+			To(Equal(1.0))
+	})
+})
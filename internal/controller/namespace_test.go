@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+var _ = Describe("Target namespace", func() {
+	var (
+		ctx      context.Context
+		r        *WorkloadScheduleReconciler
+		recorder *record.FakeRecorder
+		key      types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		ws := &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "typo", Namespace: "default", Finalizers: []string{FinalizerName}},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				Timezone:           "UTC",
+				TargetNamespace:    "dmeo",
+				TargetDeployment:   "demo-deployment",
+				ReplicasWhenActive: 1,
+			},
+		}
+		key = types.NamespacedName{Namespace: "default", Name: "typo"}
+		recorder = record.NewFakeRecorder(10)
+		r = &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(ws).
+				WithStatusSubresource(ws).
+				WithIndex(&infrav1alpha1.WorkloadSchedule{}, index.TargetField, index.ByTarget).Build(),
+			Scheme:   scheme,
+			Recorder: recorder,
+		}
+	})
+
+	It("should report a missing namespace", func() {
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueInterval))
+
+		err = r.Get(ctx, types.NamespacedName{Name: "dmeo"}, &corev1.Namespace{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		ws := &infrav1alpha1.WorkloadSchedule{}
+		Expect(r.Get(ctx, key, ws)).To(Succeed())
+		ready := meta.FindStatusCondition(ws.Status.Conditions, ConditionTypeReady)
+		Expect(ready).NotTo(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(ReasonTargetNamespaceMissing))
+		Expect(<-recorder.Events).To(ContainSubstring("Warning TargetNamespaceMissing"))
+
+		By("recording the Event only once")
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should reject a target outside the watched namespaces", func() {
+		r.WatchNamespaces = []string{"default", "demo"}
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueInterval))
+
+		err = r.Get(ctx, types.NamespacedName{Name: "dmeo"}, &corev1.Namespace{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		ws := &infrav1alpha1.WorkloadSchedule{}
+		Expect(r.Get(ctx, key, ws)).To(Succeed())
+		ready := meta.FindStatusCondition(ws.Status.Conditions, ConditionTypeReady)
+		Expect(ready).NotTo(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(ReasonTargetNamespaceNotWatched))
+		Expect(ready.Message).To(ContainSubstring("watching default, demo"))
+		Expect(<-recorder.Events).To(ContainSubstring("Warning TargetNamespaceNotWatched"))
+
+		By("recording the Event only once")
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
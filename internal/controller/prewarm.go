@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// isWithinPreWarm checks if currentTime falls within the preWarm lead time before the next
+// window opens. It returns the start of the upcoming window along with the result.
+func isWithinPreWarm(currentTime time.Time, c calendar, preWarm *metav1.Duration) (time.Time, bool) {
+	if preWarm == nil || preWarm.Duration <= 0 {
+		return time.Time{}, false
+	}
+
+	// A calendar that never opens has nothing to pre-warm
+	windowStart, ok := nextWindowStart(currentTime, c)
+	if !ok {
+		return time.Time{}, false
+	}
+	return windowStart, windowStart.Sub(currentTime) <= preWarm.Duration
+}
+
+// updatePreWarmStatus records when the target became available relative to the start of a
+// pre-warmed window. Availability is tracked from the moment pre-warming begins until the target
+// first reports ReplicasWhenActive available replicas, even if that happens after the window opened.
+func updatePreWarmStatus(ws *infrav1alpha1.WorkloadSchedule, preWarming, withinActiveWindow bool,
+	windowStart, currentTime time.Time) {
+	if preWarming && (ws.Status.PreWarm == nil || !ws.Status.PreWarm.WindowStart.Time.Equal(windowStart)) {
+		ws.Status.PreWarm = &infrav1alpha1.PreWarmStatus{WindowStart: metav1.NewTime(windowStart)}
+	}
+
+	status := ws.Status.PreWarm
+	if status == nil || status.AvailableTime != nil || (!preWarming && !withinActiveWindow) {
+		return
+	}
+
+	if ws.Status.AvailableReplicas >= ws.Spec.ReplicasWhenActive {
+		availableTime := metav1.NewTime(currentTime)
+		status.AvailableTime = &availableTime
+		status.AvailableBeforeStart = currentTime.Before(status.WindowStart.Time)
+	}
+}
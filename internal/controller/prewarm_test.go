@@ -0,0 +1,84 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Pre-warm", func() {
+	toronto := time.FixedZone("EST", -5*60*60)
+	preWarm := &metav1.Duration{Duration: 10 * time.Minute}
+
+	It("should pre-warm within the lead time before the window opens", func() {
+		windowStart, preWarming := isWithinPreWarm(time.Date(2025, 1, 6, 8, 52, 0, 0, toronto), dailyCalendar(9, 17), preWarm)
+		Expect(preWarming).To(BeTrue())
+		Expect(windowStart).To(Equal(time.Date(2025, 1, 6, 9, 0, 0, 0, toronto)))
+
+		_, preWarming = isWithinPreWarm(time.Date(2025, 1, 6, 8, 45, 0, 0, toronto), dailyCalendar(9, 17), preWarm)
+		Expect(preWarming).To(BeFalse())
+	})
+
+	It("should pre-warm across midnight for windows starting at hour 0", func() {
+		windowStart, preWarming := isWithinPreWarm(time.Date(2025, 1, 6, 23, 55, 0, 0, toronto), dailyCalendar(0, 6), preWarm)
+		Expect(preWarming).To(BeTrue())
+		Expect(windowStart).To(Equal(time.Date(2025, 1, 7, 0, 0, 0, 0, toronto)))
+	})
+
+	It("should not pre-warm without a lead time or for a window that never opens", func() {
+		_, preWarming := isWithinPreWarm(time.Date(2025, 1, 6, 8, 55, 0, 0, toronto), dailyCalendar(9, 17), nil)
+		Expect(preWarming).To(BeFalse())
+
+		_, preWarming = isWithinPreWarm(time.Date(2025, 1, 6, 8, 55, 0, 0, toronto), dailyCalendar(9, 9), preWarm)
+		Expect(preWarming).To(BeFalse())
+	})
+
+	It("should record whether the target was available before the window opened", func() {
+		ws := &infrav1alpha1.WorkloadSchedule{Spec: infrav1alpha1.WorkloadScheduleSpec{ReplicasWhenActive: 3}}
+		windowStart := time.Date(2025, 1, 6, 9, 0, 0, 0, toronto)
+
+		By("starting to track availability when pre-warming begins")
+		ws.Status.AvailableReplicas = 0
+		updatePreWarmStatus(ws, true, false, windowStart, windowStart.Add(-8*time.Minute))
+		Expect(ws.Status.PreWarm).NotTo(BeNil())
+		Expect(ws.Status.PreWarm.AvailableTime).To(BeNil())
+
+		By("recording the time the target became available")
+		ws.Status.AvailableReplicas = 3
+		updatePreWarmStatus(ws, true, false, windowStart, windowStart.Add(-2*time.Minute))
+		Expect(ws.Status.PreWarm.AvailableTime).NotTo(BeNil())
+		Expect(ws.Status.PreWarm.AvailableBeforeStart).To(BeTrue())
+	})
+
+	It("should report late availability once the window has opened", func() {
+		ws := &infrav1alpha1.WorkloadSchedule{Spec: infrav1alpha1.WorkloadScheduleSpec{ReplicasWhenActive: 3}}
+		windowStart := time.Date(2025, 1, 6, 9, 0, 0, 0, toronto)
+
+		updatePreWarmStatus(ws, true, false, windowStart, windowStart.Add(-5*time.Minute))
+		ws.Status.AvailableReplicas = 3
+		updatePreWarmStatus(ws, false, true, time.Time{}, windowStart.Add(3*time.Minute))
+		Expect(ws.Status.PreWarm.AvailableTime).NotTo(BeNil())
+		Expect(ws.Status.PreWarm.AvailableBeforeStart).To(BeFalse())
+	})
+})
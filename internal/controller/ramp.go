@@ -0,0 +1,105 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+const (
+	// DefaultRampInterval is the time between ramp steps when RampStrategy.Interval is unset
+	DefaultRampInterval = 60 * time.Second
+
+	// DefaultRampStepSize is the number of replicas changed per step when RampStrategy.StepSize is unset
+	DefaultRampStepSize = int32(1)
+)
+
+// nextRampStep computes the replica count to apply on this reconcile when walking from
+// currentReplicas toward desiredReplicas. It returns the replicas to scale to, the updated
+// ramp progress (nil once the target is reached) and how long to wait before the next step.
+// A nil strategy means no ramping, so the desired replicas are returned as-is.
+func nextRampStep(strategy *infrav1alpha1.RampStrategy, progress *infrav1alpha1.RampStatus,
+	currentReplicas, desiredReplicas int32, now time.Time) (int32, *infrav1alpha1.RampStatus, time.Duration) {
+	if strategy == nil || currentReplicas == desiredReplicas {
+		return desiredReplicas, nil, 0
+	}
+
+	interval := DefaultRampInterval
+	if strategy.Interval != nil && strategy.Interval.Duration > 0 {
+		interval = strategy.Interval.Duration
+	}
+
+	// Start a new ramp when none is in progress or the target moved (e.g. the window closed mid-ramp)
+	if progress == nil || progress.TargetReplicas != desiredReplicas {
+		progress = &infrav1alpha1.RampStatus{
+			FromReplicas:   currentReplicas,
+			TargetReplicas: desiredReplicas,
+			StartTime:      metav1.NewTime(now),
+		}
+	} else {
+		progress = progress.DeepCopy()
+	}
+
+	// Wait out the rest of the interval if the previous step was applied recently
+	if progress.LastStepTime != nil {
+		if elapsed := now.Sub(progress.LastStepTime.Time); elapsed < interval {
+			return currentReplicas, progress, interval - elapsed
+		}
+	}
+
+	step := rampStepSize(strategy, progress, interval)
+	next := desiredReplicas
+	if desiredReplicas > currentReplicas && currentReplicas+step < desiredReplicas {
+		next = currentReplicas + step
+	} else if desiredReplicas < currentReplicas && currentReplicas-step > desiredReplicas {
+		next = currentReplicas - step
+	}
+
+	if next == desiredReplicas {
+		return next, nil, 0
+	}
+
+	stepTime := metav1.NewTime(now)
+	progress.LastStepTime = &stepTime
+	return next, progress, interval
+}
+
+// rampStepSize returns the number of replicas to change per step. When a total Duration is
+// configured, the full delta of the ramp is spread evenly over Duration/interval steps.
+func rampStepSize(strategy *infrav1alpha1.RampStrategy, progress *infrav1alpha1.RampStatus, interval time.Duration) int32 {
+	if strategy.Duration != nil && strategy.Duration.Duration > 0 {
+		steps := int32(strategy.Duration.Duration / interval)
+		if steps < 1 {
+			steps = 1
+		}
+		delta := progress.TargetReplicas - progress.FromReplicas
+		if delta < 0 {
+			delta = -delta
+		}
+		// Round up so the ramp never takes longer than the configured duration
+		return max((delta+steps-1)/steps, 1)
+	}
+
+	if strategy.StepSize > 0 {
+		return strategy.StepSize
+	}
+	return DefaultRampStepSize
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Ramp strategy", func() {
+	now := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+
+	It("should scale in a single step when no strategy is set", func() {
+		next, progress, wait := nextRampStep(nil, nil, 0, 5, now)
+		Expect(next).To(Equal(int32(5)))
+		Expect(progress).To(BeNil())
+		Expect(wait).To(BeZero())
+	})
+
+	It("should walk up by the step size and record progress", func() {
+		strategy := &infrav1alpha1.RampStrategy{StepSize: 2, Interval: &metav1.Duration{Duration: 30 * time.Second}}
+
+		next, progress, wait := nextRampStep(strategy, nil, 0, 5, now)
+		Expect(next).To(Equal(int32(2)))
+		Expect(progress).NotTo(BeNil())
+		Expect(progress.FromReplicas).To(Equal(int32(0)))
+		Expect(progress.TargetReplicas).To(Equal(int32(5)))
+		Expect(progress.LastStepTime.Time).To(Equal(now))
+		Expect(wait).To(Equal(30 * time.Second))
+
+		By("holding the current replicas until the interval has elapsed")
+		held, progress, wait := nextRampStep(strategy, progress, 2, 5, now.Add(10*time.Second))
+		Expect(held).To(Equal(int32(2)))
+		Expect(wait).To(Equal(20 * time.Second))
+
+		By("applying the next steps once the interval has elapsed")
+		next, progress, _ = nextRampStep(strategy, progress, 2, 5, now.Add(30*time.Second))
+		Expect(next).To(Equal(int32(4)))
+		next, progress, wait = nextRampStep(strategy, progress, 4, 5, now.Add(60*time.Second))
+		Expect(next).To(Equal(int32(5)))
+		Expect(progress).To(BeNil())
+		Expect(wait).To(BeZero())
+	})
+
+	It("should ramp down toward zero", func() {
+		strategy := &infrav1alpha1.RampStrategy{StepSize: 3}
+
+		next, progress, wait := nextRampStep(strategy, nil, 7, 0, now)
+		Expect(next).To(Equal(int32(4)))
+		Expect(progress.TargetReplicas).To(Equal(int32(0)))
+		Expect(wait).To(Equal(DefaultRampInterval))
+	})
+
+	It("should derive the step size from the total duration", func() {
+		strategy := &infrav1alpha1.RampStrategy{
+			Interval: &metav1.Duration{Duration: time.Minute},
+			Duration: &metav1.Duration{Duration: 4 * time.Minute},
+		}
+
+		next, _, _ := nextRampStep(strategy, nil, 0, 10, now)
+		Expect(next).To(Equal(int32(3)))
+	})
+
+	It("should restart the ramp when the target changes", func() {
+		strategy := &infrav1alpha1.RampStrategy{StepSize: 1}
+		progress := &infrav1alpha1.RampStatus{
+			FromReplicas:   0,
+			TargetReplicas: 5,
+			StartTime:      metav1.NewTime(now),
+			LastStepTime:   &metav1.Time{Time: now},
+		}
+
+		next, progress, _ := nextRampStep(strategy, progress, 2, 0, now.Add(time.Second))
+		Expect(next).To(Equal(int32(1)))
+		Expect(progress.FromReplicas).To(Equal(int32(2)))
+		Expect(progress.TargetReplicas).To(Equal(int32(0)))
+	})
+})
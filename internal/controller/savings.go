@@ -0,0 +1,190 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+const (
+	// MaxSavingsInterval bounds the time added to the savings at once. Longer gaps, e.g. while the
+	// operator was down or the schedule was superseded, are skipped since the replicas are unknown.
+	MaxSavingsInterval = 10 * RequeueInterval
+
+	// PricingKeyCPUCoreHour is the pricing ConfigMap key holding the price of one CPU core for an hour
+	PricingKeyCPUCoreHour = "cpuCoreHour"
+
+	// PricingKeyMemoryGiBHour is the pricing ConfigMap key holding the price of one GiB of memory for an hour
+	PricingKeyMemoryGiBHour = "memoryGiBHour"
+
+	// PricingKeyCurrency is the pricing ConfigMap key holding the currency of the prices
+	PricingKeyCurrency = "currency"
+
+	// DefaultCurrency is used when the pricing ConfigMap does not name a currency
+	DefaultCurrency = "USD"
+)
+
+// pricing holds the rates used to turn avoided resources into a currency estimate
+type pricing struct {
+	cpuCoreHour   float64
+	memoryGiBHour float64
+	currency      string
+}
+
+// savingsIncrement is what one call to accountSavings added to the status totals
+type savingsIncrement struct {
+	replicaHours   float64
+	cpuCoreHours   float64
+	memoryGiBHours float64
+	cost           float64
+	currency       string
+}
+
+// record adds the increment to the savings counters. It must only be called once the status holding
+// the increment was persisted, otherwise a failed update would count the interval twice.
+func (inc savingsIncrement) record(ws *infrav1alpha1.WorkloadSchedule) {
+	if inc.replicaHours == 0 {
+		return
+	}
+	scheduleAvoidedReplicaHours.WithLabelValues(ws.Namespace, ws.Name).Add(inc.replicaHours)
+	scheduleAvoidedCPUCoreHours.WithLabelValues(ws.Namespace, ws.Name).Add(inc.cpuCoreHours)
+	scheduleAvoidedMemoryGiBHours.WithLabelValues(ws.Namespace, ws.Name).Add(inc.memoryGiBHours)
+	if inc.currency != "" {
+		scheduleEstimatedSavings.WithLabelValues(ws.Namespace, ws.Name, inc.currency).Add(inc.cost)
+	}
+}
+
+// accountSavings adds the capacity avoided since the last reconcile to ws.Status.Savings and returns
+// what it added, to be recorded once the status is updated. The replicas reported in the status are
+// assumed to have run for the whole interval, so it must be called before CurrentReplicas is updated.
+func (r *WorkloadScheduleReconciler) accountSavings(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule, now time.Time) savingsIncrement {
+	log := logf.FromContext(ctx)
+
+	savings := ws.Status.Savings
+	if savings == nil {
+		ws.Status.Savings = &infrav1alpha1.SavingsStatus{Since: metav1.NewTime(now), LastAccountedTime: metav1.NewTime(now)}
+		return savingsIncrement{}
+	}
+
+	elapsed := now.Sub(savings.LastAccountedTime.Time)
+	savings.LastAccountedTime = metav1.NewTime(now)
+	if elapsed <= 0 || elapsed > MaxSavingsInterval {
+		return savingsIncrement{}
+	}
+
+	avoided := ws.Spec.ReplicasWhenActive - ws.Status.CurrentReplicas
+	if avoided <= 0 {
+		return savingsIncrement{}
+	}
+
+	deployment := &appsv1.Deployment{}
+	key := types.NamespacedName{Namespace: ws.Spec.TargetNamespace, Name: ws.Spec.TargetDeployment}
+	if err := r.Get(ctx, key, deployment); err != nil {
+		log.Error(err, "Failed to get deployment for savings", "deployment", key)
+		return savingsIncrement{}
+	}
+	cpuCores, memoryGiB := podRequests(&deployment.Spec.Template)
+
+	replicaHours := float64(avoided) * elapsed.Hours()
+	inc := savingsIncrement{
+		replicaHours:   replicaHours,
+		cpuCoreHours:   replicaHours * cpuCores,
+		memoryGiBHours: replicaHours * memoryGiB,
+	}
+	addHours(&savings.ReplicaHoursAvoided, inc.replicaHours)
+	addHours(&savings.CPUCoreHoursAvoided, inc.cpuCoreHours)
+	addHours(&savings.MemoryGiBHoursAvoided, inc.memoryGiBHours)
+
+	prices, err := r.loadPricing(ctx)
+	if err != nil {
+		log.Error(err, "Failed to load pricing, skipping the savings estimate")
+		return inc
+	}
+	if prices == nil {
+		return inc
+	}
+	if savings.EstimatedSavings == nil || savings.Currency != prices.currency {
+		// Amounts in different currencies cannot be added up, so the estimate restarts
+		savings.EstimatedSavings = resource.NewQuantity(0, resource.DecimalSI)
+		savings.Currency = prices.currency
+	}
+	inc.cost = inc.cpuCoreHours*prices.cpuCoreHour + inc.memoryGiBHours*prices.memoryGiBHour
+	inc.currency = prices.currency
+	addHours(savings.EstimatedSavings, inc.cost)
+	return inc
+}
+
+// loadPricing reads the prices from the configured ConfigMap, or returns nil when none is configured
+func (r *WorkloadScheduleReconciler) loadPricing(ctx context.Context) (*pricing, error) {
+	if r.PricingConfigMap.Name == "" {
+		return nil, nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := r.Get(ctx, r.PricingConfigMap, cm); err != nil {
+		return nil, fmt.Errorf("failed to get pricing ConfigMap %s: %w", r.PricingConfigMap, err)
+	}
+
+	prices := &pricing{currency: cm.Data[PricingKeyCurrency]}
+	if prices.currency == "" {
+		prices.currency = DefaultCurrency
+	}
+	for key, price := range map[string]*float64{
+		PricingKeyCPUCoreHour:   &prices.cpuCoreHour,
+		PricingKeyMemoryGiBHour: &prices.memoryGiBHour,
+	} {
+		value, ok := cm.Data[key]
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("invalid %s %q in pricing ConfigMap %s", key, value, r.PricingConfigMap)
+		}
+		*price = parsed
+	}
+	return prices, nil
+}
+
+// podRequests returns the CPU cores and GiB of memory requested by one pod of the template
+func podRequests(template *corev1.PodTemplateSpec) (float64, float64) {
+	cpu, memory := resource.Quantity{}, resource.Quantity{}
+	for _, container := range template.Spec.Containers {
+		cpu.Add(container.Resources.Requests[corev1.ResourceCPU])
+		memory.Add(container.Resources.Requests[corev1.ResourceMemory])
+	}
+	return cpu.AsApproximateFloat64(), memory.AsApproximateFloat64() / (1 << 30)
+}
+
+// addHours adds an amount to a cumulative quantity with micro precision, which keeps the rounding
+// error of frequent small intervals negligible
+func addHours(total *resource.Quantity, amount float64) {
+	total.Add(*resource.NewScaledQuantity(int64(math.Round(amount*1e6)), resource.Micro))
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Savings", func() {
+	var (
+		ctx context.Context
+		ws  *infrav1alpha1.WorkloadSchedule
+		now time.Time
+	)
+
+	newReconciler := func(objs ...client.Object) *WorkloadScheduleReconciler {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "savings-deployment", Namespace: "demo"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Name: "app", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("250m"),
+					corev1.ResourceMemory: resource.MustParse("512Mi"),
+				}}},
+				{Name: "sidecar", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("250m"),
+					corev1.ResourceMemory: resource.MustParse("512Mi"),
+				}}},
+			}}}},
+		}
+		return &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, deployment)...).Build(),
+			Scheme: scheme,
+		}
+	}
+
+	pricingConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "pricing", Namespace: "system"}, Data: data}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2025, 3, 3, 20, 0, 0, 0, time.UTC)
+		ws = &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "savings-schedule", Namespace: "default"},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				TargetNamespace:    "demo",
+				TargetDeployment:   "savings-deployment",
+				ReplicasWhenActive: 3,
+			},
+			Status: infrav1alpha1.WorkloadScheduleStatus{CurrentReplicas: 1},
+		}
+	})
+
+	AfterEach(func() {
+		deleteScheduleMetrics(ws)
+	})
+
+	It("should start accounting on the first reconcile", func() {
+		newReconciler().accountSavings(ctx, ws, now)
+		Expect(ws.Status.Savings).NotTo(BeNil())
+		Expect(ws.Status.Savings.Since.Time).To(Equal(now))
+		Expect(ws.Status.Savings.ReplicaHoursAvoided.IsZero()).To(BeTrue())
+	})
+
+	It("should add the replicas and requests avoided since the last reconcile", func() {
+		r := newReconciler()
+		for i := range 3 {
+			r.accountSavings(ctx, ws, now.Add(time.Duration(i)*time.Minute)).record(ws)
+		}
+
+		// Two of three replicas avoided for two minutes, each requesting 500m and 1Gi
+		savings := ws.Status.Savings
+		Expect(savings.ReplicaHoursAvoided.AsApproximateFloat64()).To(BeNumerically("~", 4.0/60, 1e-6))
+		Expect(savings.CPUCoreHoursAvoided.AsApproximateFloat64()).To(BeNumerically("~", 2.0/60, 1e-6))
+		Expect(savings.MemoryGiBHoursAvoided.AsApproximateFloat64()).To(BeNumerically("~", 4.0/60, 1e-6))
+		Expect(savings.EstimatedSavings).To(BeNil())
+		Expect(testutil.ToFloat64(scheduleAvoidedReplicaHours.WithLabelValues("default", "savings-schedule"))).
+			To(BeNumerically("~", 4.0/60, 1e-9))
+	})
+
+	It("should leave the counters alone until the status is persisted", func() {
+		ws.Status.Savings = &infrav1alpha1.SavingsStatus{LastAccountedTime: metav1.NewTime(now.Add(-10 * time.Minute))}
+
+		saved := newReconciler().accountSavings(ctx, ws, now)
+		Expect(ws.Status.Savings.ReplicaHoursAvoided.AsApproximateFloat64()).To(BeNumerically("~", 2.0/6, 1e-6))
+		Expect(testutil.CollectAndCount(scheduleAvoidedReplicaHours)).To(BeZero())
+
+		saved.record(ws)
+		Expect(testutil.ToFloat64(scheduleAvoidedReplicaHours.WithLabelValues("default", "savings-schedule"))).
+			To(BeNumerically("~", 2.0/6, 1e-9))
+	})
+
+	It("should skip intervals that are too long or without avoided replicas", func() {
+		r := newReconciler()
+		r.accountSavings(ctx, ws, now)
+		r.accountSavings(ctx, ws, now.Add(MaxSavingsInterval+time.Second))
+		Expect(ws.Status.Savings.ReplicaHoursAvoided.IsZero()).To(BeTrue())
+
+		ws.Status.CurrentReplicas = 5
+		r.accountSavings(ctx, ws, now.Add(MaxSavingsInterval+time.Minute))
+		Expect(ws.Status.Savings.ReplicaHoursAvoided.IsZero()).To(BeTrue())
+		Expect(ws.Status.Savings.LastAccountedTime.Time).To(Equal(now.Add(MaxSavingsInterval + time.Minute)))
+	})
+
+	It("should estimate the savings from the configured prices", func() {
+		r := newReconciler(pricingConfigMap(map[string]string{
+			PricingKeyCPUCoreHour:   "0.04",
+			PricingKeyMemoryGiBHour: "0.005",
+			PricingKeyCurrency:      "CAD",
+		}))
+		r.PricingConfigMap = types.NamespacedName{Namespace: "system", Name: "pricing"}
+		ws.Status.Savings = &infrav1alpha1.SavingsStatus{LastAccountedTime: metav1.NewTime(now.Add(-10 * time.Minute))}
+
+		r.accountSavings(ctx, ws, now).record(ws)
+
+		// 2 replicas for 10 minutes: 1/6 core-hour at 0.04 plus 1/3 GiB-hour at 0.005
+		expected := 0.04/6 + 0.005/3
+		Expect(ws.Status.Savings.Currency).To(Equal("CAD"))
+		Expect(ws.Status.Savings.EstimatedSavings.AsApproximateFloat64()).To(BeNumerically("~", expected, 1e-6))
+		Expect(testutil.ToFloat64(scheduleEstimatedSavings.WithLabelValues("default", "savings-schedule", "CAD"))).
+			To(BeNumerically("~", expected, 1e-9))
+	})
+
+	It("should reject invalid prices", func() {
+		r := newReconciler(pricingConfigMap(map[string]string{PricingKeyCPUCoreHour: "cheap"}))
+		r.PricingConfigMap = types.NamespacedName{Namespace: "system", Name: "pricing"}
+		_, err := r.loadPricing(ctx)
+		Expect(err).To(MatchError(ContainSubstring(PricingKeyCPUCoreHour)))
+
+		r = newReconciler(pricingConfigMap(map[string]string{PricingKeyMemoryGiBHour: "0.01"}))
+		r.PricingConfigMap = types.NamespacedName{Namespace: "system", Name: "pricing"}
+		prices, err := r.loadPricing(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prices.currency).To(Equal(DefaultCurrency))
+	})
+})
@@ -0,0 +1,119 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Scaling through the scale subresource", func() {
+	var (
+		ctx        context.Context
+		ws         *infrav1alpha1.WorkloadSchedule
+		deployment *appsv1.Deployment
+	)
+
+	newReconciler := func(funcs interceptor.Funcs) *WorkloadScheduleReconciler {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		return &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).
+				WithInterceptorFuncs(funcs).Build(),
+			Scheme: scheme,
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		replicas := int32(1)
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "scale-deployment", Namespace: "demo", Labels: map[string]string{"app": "demo"}},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		}
+		ws = &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "scale-schedule", Namespace: "default"},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				TargetNamespace:    "demo",
+				TargetDeployment:   "scale-deployment",
+				ReplicasWhenActive: 5,
+			},
+		}
+	})
+
+	It("should only write the replicas, as the operator's field manager", func() {
+		var fieldManager string
+		r := newReconciler(interceptor.Funcs{
+			Update: func(context.Context, client.WithWatch, client.Object, ...client.UpdateOption) error {
+				Fail("the deployment must not be updated as a whole")
+				return nil
+			},
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object,
+				opts ...client.SubResourceUpdateOption) error {
+				Expect(subResource).To(Equal("scale"))
+				options := &client.SubResourceUpdateOptions{}
+				options.ApplyOptions(opts)
+				fieldManager = options.FieldManager
+				return c.SubResource(subResource).Update(ctx, obj, opts...)
+			},
+		})
+
+		_, replicas, _, err := r.scaleDeployment(ctx, ws, 5, infrav1alpha1.ScaleTriggerWindowOpened)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replicas).To(Equal(int32(5)))
+		Expect(fieldManager).To(Equal(infrav1alpha1.FieldManager))
+
+		updated := &appsv1.Deployment{}
+		Expect(r.Get(ctx, types.NamespacedName{Namespace: "demo", Name: "scale-deployment"}, updated)).To(Succeed())
+		Expect(*updated.Spec.Replicas).To(Equal(int32(5)))
+		Expect(updated.Labels).To(HaveKeyWithValue("app", "demo"))
+	})
+
+	It("should report conflicts and keep the ramp where it was", func() {
+		r := newReconciler(interceptor.Funcs{
+			SubResourceUpdate: func(context.Context, client.Client, string, client.Object, ...client.SubResourceUpdateOption) error {
+				return apierrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"},
+					"scale-deployment", nil)
+			},
+		})
+		ws.Spec.RampStrategy = &infrav1alpha1.RampStrategy{Interval: &metav1.Duration{Duration: time.Minute}}
+		previous := &infrav1alpha1.RampStatus{FromReplicas: 0, TargetReplicas: 5}
+		ws.Status.Ramp = previous
+
+		_, replicas, _, err := r.scaleDeployment(ctx, ws, 5, infrav1alpha1.ScaleTriggerRamp)
+		Expect(apierrors.IsConflict(err)).To(BeTrue())
+		Expect(replicas).To(Equal(int32(1)))
+		Expect(ws.Status.Ramp).To(BeIdenticalTo(previous))
+		Expect(ws.Status.History).To(BeEmpty())
+	})
+})
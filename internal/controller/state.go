@@ -0,0 +1,145 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+const (
+	// StateScheduleAnnotation records which schedule last wrote the state ConfigMap ("<namespace>/<name>")
+	StateScheduleAnnotation = "schedule.illumin.com/schedule"
+
+	// Keys of the state ConfigMap; each one becomes a file in the pods' projected volume
+	StateKeyActive         = "active"
+	StateKeySchedule       = "schedule"
+	StateKeyWindowEnd      = "windowEnd"
+	StateKeyNextTransition = "nextTransition"
+	StateKeyTimezone       = "timezone"
+
+	// ConditionTypeStatePublished is False while the state ConfigMap cannot be published; it is
+	// removed once publishing works
+	ConditionTypeStatePublished = "StatePublished"
+
+	// StateKeyDesiredReplicas is only published with the GitOps actuation method and the ConfigMap
+	// target, and never in a dry run, since GitOps tools act on it
+	StateKeyDesiredReplicas = "desiredReplicas"
+)
+
+// errStateConfigMapNotOwned means a ConfigMap with the state ConfigMap's name exists that no schedule
+// wrote, so it is left alone
+var errStateConfigMapNotOwned = errors.New("state ConfigMap was not written by a WorkloadSchedule")
+
+// stateConfigMapData renders the schedule's current state, with times in RFC3339 in the given location
+func (r *WorkloadScheduleReconciler) stateConfigMapData(ws *infrav1alpha1.WorkloadSchedule,
+	location *time.Location) map[string]string {
+	data := map[string]string{
+		StateKeyActive:   strconv.FormatBool(ws.Status.WithinActiveWindow),
+		StateKeySchedule: types.NamespacedName{Namespace: ws.Namespace, Name: ws.Name}.String(),
+		StateKeyTimezone: ws.ScheduleTimezone(),
+	}
+	if ws.Status.WindowEnd != nil {
+		data[StateKeyWindowEnd] = ws.Status.WindowEnd.In(location).Format(time.RFC3339)
+	}
+	if ws.Status.NextTransition != nil {
+		data[StateKeyNextTransition] = ws.Status.NextTransition.In(location).Format(time.RFC3339)
+	}
+	if publishesTo(ws, infrav1alpha1.PublishTargetConfigMap) && !r.isDryRun(ws) {
+		data[StateKeyDesiredReplicas] = strconv.Itoa(int(ws.Status.DesiredReplicas))
+	}
+	return data
+}
+
+// syncStateConfigMap publishes the schedule's state to the ConfigMap the Pod webhook mounts into the
+// target's pods. The kubelet refreshes mounted ConfigMaps, so running pods see transitions without a restart.
+func (r *WorkloadScheduleReconciler) syncStateConfigMap(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule,
+	location *time.Location) error {
+	data := r.stateConfigMapData(ws, location)
+	owner := types.NamespacedName{Namespace: ws.Namespace, Name: ws.Name}.String()
+
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: ws.Spec.TargetNamespace, Name: infrav1alpha1.StateConfigMapName(ws.Spec.TargetDeployment)}
+	err := r.Get(ctx, key, configMap)
+	if apierrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        key.Name,
+				Namespace:   key.Namespace,
+				Annotations: map[string]string{StateScheduleAnnotation: owner},
+			},
+			Data: data,
+		}
+		if err := r.Create(ctx, configMap); err != nil {
+			return fmt.Errorf("failed to create state ConfigMap: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get state ConfigMap: %w", err)
+	}
+	// A ConfigMap that only shares the name belongs to someone else. One written by another schedule
+	// is taken over, since the target's pods follow whichever schedule manages it.
+	if _, written := configMap.Annotations[StateScheduleAnnotation]; !written {
+		return fmt.Errorf("%w: %s has no %s annotation", errStateConfigMapNotOwned, key, StateScheduleAnnotation)
+	}
+
+	if maps.Equal(configMap.Data, data) && configMap.Annotations[StateScheduleAnnotation] == owner {
+		return nil
+	}
+	if configMap.Annotations == nil {
+		configMap.Annotations = make(map[string]string)
+	}
+	configMap.Annotations[StateScheduleAnnotation] = owner
+	configMap.Data = data
+	if err := r.Update(ctx, configMap); err != nil {
+		return fmt.Errorf("failed to update state ConfigMap: %w", err)
+	}
+	return nil
+}
+
+// deleteStateConfigMap removes the state ConfigMap if this schedule was the last to write it.
+// A schedule taking over the target rewrites it on its next reconcile.
+func (r *WorkloadScheduleReconciler) deleteStateConfigMap(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule) error {
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: ws.Spec.TargetNamespace, Name: infrav1alpha1.StateConfigMapName(ws.Spec.TargetDeployment)}
+	if err := r.Get(ctx, key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get state ConfigMap: %w", err)
+	}
+
+	if configMap.Annotations[StateScheduleAnnotation] != (types.NamespacedName{Namespace: ws.Namespace, Name: ws.Name}).String() {
+		return nil
+	}
+	if err := r.Delete(ctx, configMap); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete state ConfigMap: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+var _ = Describe("State ConfigMap", func() {
+	var (
+		ctx context.Context
+		r   *WorkloadScheduleReconciler
+		ws  *infrav1alpha1.WorkloadSchedule
+	)
+	toronto := time.FixedZone("EST", -5*60*60)
+	key := types.NamespacedName{Namespace: "demo", Name: "demo-deployment-workload-schedule"}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		r = &WorkloadScheduleReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), Scheme: scheme}
+
+		windowEnd := metav1.NewTime(time.Date(2025, 1, 6, 22, 0, 0, 0, time.UTC))
+		ws = &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "business-hours", Namespace: "default"},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				Timezone:         "America/Toronto",
+				TargetNamespace:  "demo",
+				TargetDeployment: "demo-deployment",
+			},
+			Status: infrav1alpha1.WorkloadScheduleStatus{
+				WithinActiveWindow: true,
+				WindowEnd:          &windowEnd,
+				NextTransition:     &windowEnd,
+			},
+		}
+	})
+
+	It("should publish the current state and follow transitions", func() {
+		Expect(r.syncStateConfigMap(ctx, ws, toronto)).To(Succeed())
+
+		configMap := &corev1.ConfigMap{}
+		Expect(r.Get(ctx, key, configMap)).To(Succeed())
+		Expect(configMap.Annotations).To(HaveKeyWithValue(StateScheduleAnnotation, "default/business-hours"))
+		Expect(configMap.Data).To(Equal(map[string]string{
+			StateKeyActive:         "true",
+			StateKeySchedule:       "default/business-hours",
+			StateKeyWindowEnd:      "2025-01-06T17:00:00-05:00",
+			StateKeyNextTransition: "2025-01-06T17:00:00-05:00",
+			StateKeyTimezone:       "America/Toronto",
+		}))
+
+		ws.Status.WithinActiveWindow = false
+		Expect(r.syncStateConfigMap(ctx, ws, toronto)).To(Succeed())
+		Expect(r.Get(ctx, key, configMap)).To(Succeed())
+		Expect(configMap.Data).To(HaveKeyWithValue(StateKeyActive, "false"))
+	})
+
+	It("should only delete the ConfigMap when this schedule wrote it", func() {
+		Expect(r.syncStateConfigMap(ctx, ws, toronto)).To(Succeed())
+
+		other := ws.DeepCopy()
+		other.Name = "weekend"
+		Expect(r.deleteStateConfigMap(ctx, other)).To(Succeed())
+		Expect(r.Get(ctx, key, &corev1.ConfigMap{})).To(Succeed())
+
+		Expect(r.deleteStateConfigMap(ctx, ws)).To(Succeed())
+		Expect(apierrors.IsNotFound(r.Get(ctx, key, &corev1.ConfigMap{}))).To(BeTrue())
+
+		By("tolerating a ConfigMap that is already gone")
+		Expect(r.deleteStateConfigMap(ctx, ws)).To(Succeed())
+	})
+
+	It("should leave a ConfigMap alone that no schedule wrote", func() {
+		userConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Data:       map[string]string{"settings": "keep"},
+		}
+		Expect(r.Create(ctx, userConfigMap)).To(Succeed())
+
+		Expect(r.syncStateConfigMap(ctx, ws, toronto)).To(MatchError(errStateConfigMapNotOwned))
+		configMap := &corev1.ConfigMap{}
+		Expect(r.Get(ctx, key, configMap)).To(Succeed())
+		Expect(configMap.Data).To(Equal(map[string]string{"settings": "keep"}))
+
+		By("taking over one written by another schedule")
+		configMap.Annotations = map[string]string{StateScheduleAnnotation: "default/weekend"}
+		Expect(r.Update(ctx, configMap)).To(Succeed())
+		Expect(r.syncStateConfigMap(ctx, ws, toronto)).To(Succeed())
+		Expect(r.Get(ctx, key, configMap)).To(Succeed())
+		Expect(configMap.Annotations).To(HaveKeyWithValue(StateScheduleAnnotation, "default/business-hours"))
+	})
+
+	It("should report a ConfigMap it may not replace on the schedule", func() {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		replicas := int32(1)
+		ws = &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "business-hours", Namespace: "demo", Finalizers: []string{FinalizerName}},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				Timezone:           "UTC",
+				StartHour:          9,
+				EndHour:            17,
+				TargetNamespace:    "demo",
+				TargetDeployment:   "demo-deployment",
+				ReplicasWhenActive: 1,
+			},
+		}
+		recorder := record.NewFakeRecorder(10)
+		r = &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(ws, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "demo"}},
+					&appsv1.Deployment{
+						ObjectMeta: metav1.ObjectMeta{Name: "demo-deployment", Namespace: "demo"},
+						Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+					},
+					&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}).
+				WithStatusSubresource(ws).
+				WithIndex(&infrav1alpha1.WorkloadSchedule{}, index.TargetField, index.ByTarget).Build(),
+			Scheme:     scheme,
+			Recorder:   recorder,
+			HTTPClient: &http.Client{Transport: fixedTimeTransport{now: time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC)}},
+		}
+		scheduleKey := types.NamespacedName{Namespace: "demo", Name: "business-hours"}
+		DeferCleanup(deleteScheduleMetrics, ws)
+
+		for range 2 {
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: scheduleKey})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(r.Get(ctx, scheduleKey, ws)).To(Succeed())
+		published := meta.FindStatusCondition(ws.Status.Conditions, ConditionTypeStatePublished)
+		Expect(published).NotTo(BeNil())
+		Expect(published.Status).To(Equal(metav1.ConditionFalse))
+		Expect(published.Reason).To(Equal("ConfigMapNotOwned"))
+		Expect(meta.IsStatusConditionTrue(ws.Status.Conditions, ConditionTypeReady)).To(BeTrue())
+
+		var notOwned []string
+		for len(recorder.Events) > 0 {
+			if event := <-recorder.Events; strings.Contains(event, "StateConfigMapNotOwned") {
+				notOwned = append(notOwned, event)
+			}
+		}
+		Expect(notOwned).To(HaveLen(1))
+	})
+})
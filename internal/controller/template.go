@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+const (
+	// TemplateIndexField indexes WorkloadSchedules by "<kind>/<namespace>/<name>" of the template they
+	// reference, with an empty namespace for ClusterScheduleTemplates
+	TemplateIndexField = ".spec.templateRef"
+
+	// ReasonTemplateNotFound is the Ready reason while the template a schedule references cannot be read
+	ReasonTemplateNotFound = "TemplateNotFound"
+)
+
+// +kubebuilder:rbac:groups=infra.illumin.com,resources=scheduletemplates;clusterscheduletemplates,verbs=get;list;watch
+
+// templateKey returns the index key for a template
+func templateKey(kind infrav1alpha1.ScheduleTemplateKind, namespace, name string) string {
+	return string(kind) + "/" + namespace + "/" + name
+}
+
+// indexByTemplate is the field indexer for TemplateIndexField
+func indexByTemplate(obj client.Object) []string {
+	ws, ok := obj.(*infrav1alpha1.WorkloadSchedule)
+	if !ok || ws.Spec.TemplateRef == nil {
+		return nil
+	}
+	ref := ws.Spec.TemplateRef
+	if ref.TemplateKind() == infrav1alpha1.ScheduleTemplateKindCluster {
+		return []string{templateKey(infrav1alpha1.ScheduleTemplateKindCluster, "", ref.Name)}
+	}
+	return []string{templateKey(infrav1alpha1.ScheduleTemplateKindNamespaced, ws.Namespace, ref.Name)}
+}
+
+// scheduleCalendar returns the calendar and timezone the schedule follows, from its template when it
+// has a TemplateRef. The message explains why the template could not be used when it is non-empty.
+func (r *WorkloadScheduleReconciler) scheduleCalendar(ctx context.Context,
+	ws *infrav1alpha1.WorkloadSchedule) (calendar, string, string, error) {
+	ref := ws.Spec.TemplateRef
+	if ref == nil {
+		return dailyCalendar(ws.Spec.StartHour, ws.Spec.EndHour), ws.Spec.Timezone, "", nil
+	}
+
+	var spec *infrav1alpha1.ScheduleTemplateSpec
+	var err error
+	switch ref.TemplateKind() {
+	case infrav1alpha1.ScheduleTemplateKindCluster:
+		// Cluster-scoped objects cannot be granted by the namespaced Roles of a scoped installation
+		if len(r.WatchNamespaces) > 0 {
+			return calendar{}, "", fmt.Sprintf(
+				"ClusterScheduleTemplate %s cannot be read by an operator watching only some namespaces", ref.Name), nil
+		}
+		template := &infrav1alpha1.ClusterScheduleTemplate{}
+		err = r.Get(ctx, types.NamespacedName{Name: ref.Name}, template)
+		spec = &template.Spec
+	default:
+		template := &infrav1alpha1.ScheduleTemplate{}
+		err = r.Get(ctx, types.NamespacedName{Namespace: ws.Namespace, Name: ref.Name}, template)
+		spec = &template.Spec
+	}
+	if apierrors.IsNotFound(err) {
+		return calendar{}, "", fmt.Sprintf("%s %s does not exist", ref.TemplateKind(), ref.Name), nil
+	}
+	if err != nil {
+		return calendar{}, "", "", fmt.Errorf("failed to get %s %s: %w", ref.TemplateKind(), ref.Name, err)
+	}
+	return templateCalendar(spec), spec.Timezone, "", nil
+}
+
+// findSchedulesForTemplate maps a ScheduleTemplate or ClusterScheduleTemplate to every schedule that
+// references it, so a change to shared office hours reaches all of them without waiting for the
+// next requeue
+func (r *WorkloadScheduleReconciler) findSchedulesForTemplate(ctx context.Context, obj client.Object) []reconcile.Request {
+	var key string
+	switch obj.(type) {
+	case *infrav1alpha1.ClusterScheduleTemplate:
+		key = templateKey(infrav1alpha1.ScheduleTemplateKindCluster, "", obj.GetName())
+	default:
+		key = templateKey(infrav1alpha1.ScheduleTemplateKindNamespaced, obj.GetNamespace(), obj.GetName())
+	}
+
+	schedules := &infrav1alpha1.WorkloadScheduleList{}
+	if err := r.List(ctx, schedules, client.MatchingFields{TemplateIndexField: key}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list schedules for template")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(schedules.Items))
+	for _, ws := range schedules.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: ws.Namespace,
+			Name:      ws.Name,
+		}})
+	}
+	return requests
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+var _ = Describe("Schedule templates", func() {
+	var (
+		ctx      context.Context
+		r        *WorkloadScheduleReconciler
+		recorder *record.FakeRecorder
+		key      types.NamespacedName
+	)
+
+	// A Saturday morning, inside the window hours but outside the template's days
+	saturday := time.Date(2025, 1, 11, 10, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+		replicas := int32(2)
+		ws := &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "demo", Finalizers: []string{FinalizerName}},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				TemplateRef:        &infrav1alpha1.ScheduleTemplateReference{Name: "office-hours"},
+				TargetNamespace:    "demo",
+				TargetDeployment:   "demo-deployment",
+				ReplicasWhenActive: 2,
+			},
+		}
+		key = types.NamespacedName{Namespace: "demo", Name: "web"}
+		recorder = record.NewFakeRecorder(10)
+		r = &WorkloadScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(ws, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "demo"}},
+					&appsv1.Deployment{
+						ObjectMeta: metav1.ObjectMeta{Name: "demo-deployment", Namespace: "demo"},
+						Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+					}).
+				WithStatusSubresource(ws).
+				WithIndex(&infrav1alpha1.WorkloadSchedule{}, index.TargetField, index.ByTarget).
+				WithIndex(&infrav1alpha1.WorkloadSchedule{}, TemplateIndexField, indexByTemplate).Build(),
+			Scheme:     scheme,
+			Recorder:   recorder,
+			HTTPClient: &http.Client{Transport: fixedTimeTransport{now: saturday}},
+		}
+	})
+
+	AfterEach(func() {
+		deleteScheduleMetrics(&infrav1alpha1.WorkloadSchedule{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}})
+	})
+
+	It("should stand down until the referenced template exists", func() {
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueInterval))
+
+		ws := &infrav1alpha1.WorkloadSchedule{}
+		Expect(r.Get(ctx, key, ws)).To(Succeed())
+		ready := meta.FindStatusCondition(ws.Status.Conditions, ConditionTypeReady)
+		Expect(ready).NotTo(BeNil())
+		Expect(ready.Reason).To(Equal(ReasonTemplateNotFound))
+		Expect(<-recorder.Events).To(ContainSubstring("Warning TemplateNotFound ScheduleTemplate office-hours does not exist"))
+	})
+
+	It("should follow the template's days and timezone", func() {
+		Expect(r.Create(ctx, &infrav1alpha1.ScheduleTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "office-hours", Namespace: "demo"},
+			Spec: infrav1alpha1.ScheduleTemplateSpec{
+				Timezone: "Etc/UTC",
+				Windows:  []infrav1alpha1.ScheduleWindow{{StartHour: 9, EndHour: 17}},
+				Days:     []infrav1alpha1.Weekday{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"},
+			},
+		})).To(Succeed())
+
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		ws := &infrav1alpha1.WorkloadSchedule{}
+		Expect(r.Get(ctx, key, ws)).To(Succeed())
+		Expect(ws.Status.WithinActiveWindow).To(BeFalse())
+		Expect(ws.Status.Timezone).To(Equal("Etc/UTC"))
+		Expect(ws.ScheduleTimezone()).To(Equal("Etc/UTC"))
+		Expect(ws.Status.NextTransition.Time).To(BeTemporally("==", time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC)))
+
+		deployment := &appsv1.Deployment{}
+		Expect(r.Get(ctx, types.NamespacedName{Namespace: "demo", Name: "demo-deployment"}, deployment)).To(Succeed())
+		Expect(*deployment.Spec.Replicas).To(BeZero())
+	})
+
+	It("should requeue every schedule referencing a changed template", func() {
+		template := &infrav1alpha1.ScheduleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "office-hours", Namespace: "demo"}}
+		Expect(r.findSchedulesForTemplate(ctx, template)).To(ConsistOf(reconcile.Request{NamespacedName: key}))
+
+		By("not confusing it with a template of the same name in another namespace or cluster scope")
+		template.Namespace = "other"
+		Expect(r.findSchedulesForTemplate(ctx, template)).To(BeEmpty())
+		Expect(r.findSchedulesForTemplate(ctx, &infrav1alpha1.ClusterScheduleTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "office-hours"},
+		})).To(BeEmpty())
+	})
+
+	It("should not read cluster templates when watching only some namespaces", func() {
+		r.WatchNamespaces = []string{"demo"}
+		ws := &infrav1alpha1.WorkloadSchedule{Spec: infrav1alpha1.WorkloadScheduleSpec{
+			TemplateRef: &infrav1alpha1.ScheduleTemplateReference{
+				Kind: infrav1alpha1.ScheduleTemplateKindCluster,
+				Name: "company-hours",
+			},
+		}}
+		_, _, message, err := r.scheduleCalendar(ctx, ws)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(message).To(ContainSubstring("cannot be read by an operator watching only some namespaces"))
+	})
+})
@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// windowEnd returns the end of the active period containing currentTime, or of the next one when
+// currentTime is outside them. The boolean is false for a calendar that never opens.
+func windowEnd(currentTime time.Time, c calendar) (time.Time, bool) {
+	p, ok := c.nextPeriod(currentTime)
+	return p.end, ok
+}
+
+// nextTransition returns the next time the schedule becomes active or inactive. The boolean is
+// false for a calendar that never opens.
+func nextTransition(currentTime time.Time, c calendar) (time.Time, bool) {
+	p, ok := c.nextPeriod(currentTime)
+	if !ok {
+		return time.Time{}, false
+	}
+	if p.start.After(currentTime) {
+		return p.start, true
+	}
+	return p.end, true
+}
+
+// updateTransitionStatus records the window end and next transition so the Pod webhook can hand
+// them to workloads without evaluating the schedule itself
+func updateTransitionStatus(ws *infrav1alpha1.WorkloadSchedule, c calendar, currentTime time.Time) {
+	ws.Status.WindowEnd = nil
+	ws.Status.NextTransition = nil
+
+	if end, ok := windowEnd(currentTime, c); ok {
+		windowEndTime := metav1.NewTime(end)
+		ws.Status.WindowEnd = &windowEndTime
+	}
+	if next, ok := nextTransition(currentTime, c); ok {
+		nextTime := metav1.NewTime(next)
+		ws.Status.NextTransition = &nextTime
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Transitions", func() {
+	toronto := time.FixedZone("EST", -5*60*60)
+
+	It("should report the end of the current window as the next transition while active", func() {
+		now := time.Date(2025, 1, 6, 9, 0, 0, 0, toronto)
+		end, ok := windowEnd(now, dailyCalendar(9, 17))
+		Expect(ok).To(BeTrue())
+		Expect(end).To(Equal(time.Date(2025, 1, 6, 17, 0, 0, 0, toronto)))
+
+		next, ok := nextTransition(now, dailyCalendar(9, 17))
+		Expect(ok).To(BeTrue())
+		Expect(next).To(Equal(end))
+	})
+
+	It("should report the next window while inactive", func() {
+		evening := time.Date(2025, 1, 6, 18, 0, 0, 0, toronto)
+		end, _ := windowEnd(evening, dailyCalendar(9, 17))
+		Expect(end).To(Equal(time.Date(2025, 1, 7, 17, 0, 0, 0, toronto)))
+		next, _ := nextTransition(evening, dailyCalendar(9, 17))
+		Expect(next).To(Equal(time.Date(2025, 1, 7, 9, 0, 0, 0, toronto)))
+
+		morning := time.Date(2025, 1, 6, 7, 30, 0, 0, toronto)
+		end, _ = windowEnd(morning, dailyCalendar(9, 17))
+		Expect(end).To(Equal(time.Date(2025, 1, 6, 17, 0, 0, 0, toronto)))
+	})
+
+	It("should end a window with endHour 24 at the following midnight", func() {
+		end, ok := windowEnd(time.Date(2025, 1, 6, 23, 30, 0, 0, toronto), dailyCalendar(20, 24))
+		Expect(ok).To(BeTrue())
+		Expect(end).To(Equal(time.Date(2025, 1, 7, 0, 0, 0, 0, toronto)))
+	})
+
+	It("should clear the transition status for a window that never opens", func() {
+		ws := &infrav1alpha1.WorkloadSchedule{}
+		updateTransitionStatus(ws, dailyCalendar(9, 17), time.Date(2025, 1, 6, 12, 0, 0, 0, toronto))
+		Expect(ws.Status.WindowEnd).NotTo(BeNil())
+		Expect(ws.Status.NextTransition).NotTo(BeNil())
+
+		updateTransitionStatus(ws, dailyCalendar(17, 17), time.Date(2025, 1, 6, 12, 0, 0, 0, toronto))
+		Expect(ws.Status.WindowEnd).To(BeNil())
+		Expect(ws.Status.NextTransition).To(BeNil())
+	})
+})
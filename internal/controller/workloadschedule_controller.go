@@ -19,20 +19,26 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
@@ -53,6 +59,13 @@ const (
 
 	// ConditionTypeSynced is the condition type for synced status
 	ConditionTypeSynced = "Synced"
+
+	// ReasonTargetNamespaceMissing is the Ready reason while the target namespace does not exist
+	ReasonTargetNamespaceMissing = "TargetNamespaceMissing"
+
+	// ReasonTargetNamespaceNotWatched is the Ready reason while the target namespace is outside the
+	// namespaces the operator is limited to
+	ReasonTargetNamespaceNotWatched = "TargetNamespaceNotWatched"
 )
 
 // WorldTimeResponse represents the response from worldtimeapi.org
@@ -71,13 +84,27 @@ type WorkloadScheduleReconciler struct {
 	client.Client
 	Scheme     *runtime.Scheme
 	HTTPClient *http.Client
+	Recorder   record.EventRecorder
+
+	// DryRun makes every schedule behave as if its mode was DryRun
+	DryRun bool
+
+	// PricingConfigMap holds the prices used to estimate savings; no estimate is made when unset
+	PricingConfigMap types.NamespacedName
+
+	// WatchNamespaces limits the operator to these namespaces; all namespaces are watched when empty
+	WatchNamespaces []string
 }
 
 // +kubebuilder:rbac:groups=infra.illumin.com,resources=workloadschedules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infra.illumin.com,resources=workloadschedules/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=infra.illumin.com,resources=workloadschedules/finalizers,verbs=update
-// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
-// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments/scale,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is the main reconciliation loop for WorkloadSchedule resources
 func (r *WorkloadScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -125,20 +152,105 @@ func (r *WorkloadScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, nil
 	}
 
-	// Ensure target namespace exists
-	if err := r.ensureNamespace(ctx, workloadSchedule.Spec.TargetNamespace); err != nil {
-		log.Error(err, "Failed to ensure namespace exists", "namespace", workloadSchedule.Spec.TargetNamespace)
+	// The target must be in a namespace the operator can see, otherwise every read of it would fail
+	if !r.watchesNamespace(workloadSchedule.Spec.TargetNamespace) {
+		return r.standDown(ctx, workloadSchedule, ReasonTargetNamespaceNotWatched, fmt.Sprintf(
+			"Target namespace %s is not watched by the operator (watching %s)",
+			workloadSchedule.Spec.TargetNamespace, strings.Join(r.WatchNamespaces, ", ")))
+	}
+
+	// The target namespace is never created, so a typo does not litter the cluster with empty namespaces
+	targetNamespace := workloadSchedule.Spec.TargetNamespace
+	exists, err := r.namespaceExists(ctx, targetNamespace)
+	if err != nil {
+		log.Error(err, "Failed to check namespace exists", "namespace", targetNamespace)
 		r.setCondition(workloadSchedule, ConditionTypeReady, metav1.ConditionFalse, "NamespaceError", err.Error())
 		if statusErr := r.Status().Update(ctx, workloadSchedule); statusErr != nil {
 			log.Error(statusErr, "Failed to update status")
 		}
 		return ctrl.Result{RequeueAfter: RequeueInterval}, err
 	}
+	if !exists {
+		return r.standDown(ctx, workloadSchedule, ReasonTargetNamespaceMissing,
+			fmt.Sprintf("Target namespace %s does not exist", targetNamespace))
+	}
+
+	permitted, err := r.targetPermitted(ctx, workloadSchedule)
+	if err != nil {
+		log.Error(err, "Failed to check the target grant")
+		return ctrl.Result{}, err
+	}
+	if !permitted {
+		return r.standDown(ctx, workloadSchedule, ReasonTargetNotGranted, fmt.Sprintf(
+			"No ScheduleTargetGrant in namespace %s allows schedules from namespace %s to target deployment %s",
+			targetNamespace, workloadSchedule.Namespace, workloadSchedule.Spec.TargetDeployment))
+	}
+	if actuation := workloadSchedule.Spec.Actuation; actuation != nil && actuation.ArgoCDApplication != nil {
+		permitted, err := r.applicationPermitted(ctx, workloadSchedule)
+		if err != nil {
+			log.Error(err, "Failed to check the Argo CD Application grant")
+			return ctrl.Result{}, err
+		}
+		if !permitted {
+			return r.standDown(ctx, workloadSchedule, ReasonArgoCDApplicationNotGranted, fmt.Sprintf(
+				"No ScheduleTargetGrant in namespace %s allows schedules from namespace %s to pause Argo CD Application %s",
+				argoCDApplicationNamespace(actuation.ArgoCDApplication), workloadSchedule.Namespace, actuation.ArgoCDApplication.Name))
+		}
+	}
+
+	// Shared windows come from the referenced template, which may not exist yet
+	cal, timezone, message, err := r.scheduleCalendar(ctx, workloadSchedule)
+	if err != nil {
+		log.Error(err, "Failed to get the schedule template")
+		return ctrl.Result{}, err
+	}
+	if message != "" {
+		return r.standDown(ctx, workloadSchedule, ReasonTemplateNotFound, message)
+	}
+
+	// Only one schedule may act on a target; the others stand down until it goes away
+	winner, others, err := r.resolveTargetConflict(ctx, workloadSchedule)
+	if err != nil {
+		log.Error(err, "Failed to check for conflicting schedules")
+		return ctrl.Result{RequeueAfter: RequeueInterval}, err
+	}
+	if winner != workloadSchedule {
+		message := fmt.Sprintf("Deployment %s/%s is managed by WorkloadSchedule %s/%s (priority %d)",
+			workloadSchedule.Spec.TargetNamespace, workloadSchedule.Spec.TargetDeployment,
+			winner.Namespace, winner.Name, winner.Spec.Priority)
+		if !meta.IsStatusConditionTrue(workloadSchedule.Status.Conditions, ConditionTypeConflicted) {
+			r.recordEvent(workloadSchedule, corev1.EventTypeWarning, "Conflicted", "%s; this schedule will not scale it", message)
+		}
+		log.Info("Target is managed by another schedule, skipping", "winner", winner.Name, "winnerNamespace", winner.Namespace)
+		if err := r.releaseArgoCDPause(ctx, workloadSchedule); err != nil {
+			log.Error(err, "Failed to lift the Argo CD Application pause")
+			return ctrl.Result{}, err
+		}
+		r.setCondition(workloadSchedule, ConditionTypeConflicted, metav1.ConditionTrue, "Superseded", message)
+		r.setCondition(workloadSchedule, ConditionTypeReady, metav1.ConditionFalse, "Conflicted", message)
+		workloadSchedule.Status.Ramp = nil
+		workloadSchedule.Status.Drain = nil
+		if err := r.Status().Update(ctx, workloadSchedule); err != nil {
+			log.Error(err, "Failed to update WorkloadSchedule status")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: RequeueInterval}, nil
+	}
+	if len(others) > 0 {
+		r.setCondition(workloadSchedule, ConditionTypeConflicted, metav1.ConditionTrue, "TakesPrecedence",
+			fmt.Sprintf("Also targeted by %s; this schedule takes precedence", scheduleNames(others)))
+	} else {
+		r.setCondition(workloadSchedule, ConditionTypeConflicted, metav1.ConditionFalse, "NoConflict",
+			"No other schedule targets this deployment")
+	}
 
 	// Get current time from World Time API
-	currentTime, err := r.getCurrentTime(ctx, workloadSchedule.Spec.Timezone)
+	currentTime, err := r.getCurrentTime(ctx, timezone)
 	if err != nil {
-		log.Error(err, "Failed to get current time from World Time API", "timezone", workloadSchedule.Spec.Timezone)
+		log.Error(err, "Failed to get current time from World Time API", "timezone", timezone)
+		timeAPIErrors.Inc()
+		r.recordTargetEvent(ctx, workloadSchedule, nil, corev1.EventTypeWarning, "TimeSourceFailed",
+			"Failed to get the current time for %s, replicas left unchanged: %v", timezone, err)
 		r.setCondition(workloadSchedule, ConditionTypeSynced, metav1.ConditionFalse, "TimeAPIError", err.Error())
 		if statusErr := r.Status().Update(ctx, workloadSchedule); statusErr != nil {
 			log.Error(statusErr, "Failed to update status")
@@ -147,19 +259,51 @@ func (r *WorkloadScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	}
 
 	// Determine if within active window
-	withinActiveWindow := r.isWithinActiveWindow(currentTime, workloadSchedule.Spec.StartHour, workloadSchedule.Spec.EndHour)
+	withinActiveWindow := isWithinActiveWindow(currentTime, cal)
 	log.Info("Time check", "currentTime", currentTime.Format(time.RFC3339), "hour", currentTime.Hour(),
-		"startHour", workloadSchedule.Spec.StartHour, "endHour", workloadSchedule.Spec.EndHour,
-		"withinActiveWindow", withinActiveWindow)
+		"timezone", timezone, "withinActiveWindow", withinActiveWindow)
+
+	// Start scaling up ahead of the window when a pre-warm lead time is configured
+	windowStart, preWarming := time.Time{}, false
+	if !withinActiveWindow {
+		windowStart, preWarming = isWithinPreWarm(currentTime, cal, workloadSchedule.Spec.PreWarm)
+		if preWarming {
+			log.Info("Pre-warming ahead of active window", "windowStart", windowStart.Format(time.RFC3339))
+		}
+	}
 
 	// Scale the deployment
 	desiredReplicas := int32(0)
-	if withinActiveWindow {
+	if withinActiveWindow || preWarming {
 		desiredReplicas = workloadSchedule.Spec.ReplicasWhenActive
 	}
 
-	scaleAction, currentReplicas, err := r.scaleDeployment(ctx, workloadSchedule.Spec.TargetNamespace,
-		workloadSchedule.Spec.TargetDeployment, desiredReplicas)
+	// Keep Argo CD from syncing the replicas back while the schedule holds the target down. Without a
+	// reference, with another method or in a dry run, a pause the schedule set earlier is lifted.
+	var argoCDErr error
+	if actuation := workloadSchedule.Spec.Actuation; actuation != nil && actuation.ArgoCDApplication != nil &&
+		actuationMethod(workloadSchedule) == infrav1alpha1.ActuationMethodScale && !r.isDryRun(workloadSchedule) {
+		argoCDErr = r.syncArgoCDPause(ctx, workloadSchedule, !withinActiveWindow && !preWarming)
+	} else {
+		argoCDErr = r.releaseArgoCDPause(ctx, workloadSchedule)
+	}
+	if argoCDErr != nil {
+		log.Error(argoCDErr, "Failed to sync Argo CD Application pause")
+		r.setCondition(workloadSchedule, ConditionTypeReady, metav1.ConditionFalse, "ArgoCDError", argoCDErr.Error())
+		if statusErr := r.Status().Update(ctx, workloadSchedule); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: RequeueInterval}, argoCDErr
+	}
+
+	trigger := scaleTrigger(workloadSchedule, withinActiveWindow, preWarming)
+	scaleAction, currentReplicas, rampWait, err := r.scaleDeployment(ctx, workloadSchedule, desiredReplicas, trigger)
+	if apierrors.IsConflict(err) {
+		// The deployment changed since it was read; retry right away with the returned error's backoff
+		// rather than waiting for the next interval
+		log.Info("Deployment changed while scaling, retrying", "reason", err.Error())
+		return ctrl.Result{}, err
+	}
 	if err != nil {
 		log.Error(err, "Failed to scale deployment")
 		r.setCondition(workloadSchedule, ConditionTypeReady, metav1.ConditionFalse, "ScaleError", err.Error())
@@ -169,35 +313,83 @@ func (r *WorkloadScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{RequeueAfter: RequeueInterval}, err
 	}
 
+	// Savings are accounted on the replicas that ran since the last reconcile, before they are replaced below
+	saved := r.accountSavings(ctx, workloadSchedule, currentTime)
+
 	// Update status
 	now := metav1.Now()
 	workloadSchedule.Status.CurrentLocalTime = currentTime.Format(time.RFC3339)
 	workloadSchedule.Status.WithinActiveWindow = withinActiveWindow
+	workloadSchedule.Status.Timezone = timezone
 	workloadSchedule.Status.LastScaleAction = scaleAction
 	workloadSchedule.Status.LastSyncTime = &now
 	workloadSchedule.Status.CurrentReplicas = currentReplicas
-
-	r.setCondition(workloadSchedule, ConditionTypeReady, metav1.ConditionTrue, "Reconciled", "Successfully reconciled")
+	workloadSchedule.Status.DesiredReplicas = desiredReplicas
+	updatePreWarmStatus(workloadSchedule, preWarming, withinActiveWindow, windowStart, currentTime)
+	updateTransitionStatus(workloadSchedule, cal, currentTime)
+	recordScheduleMetrics(workloadSchedule, desiredReplicas, currentTime)
+
+	if r.isDryRun(workloadSchedule) {
+		r.setCondition(workloadSchedule, ConditionTypeReady, metav1.ConditionTrue, "DryRun",
+			"Successfully reconciled in dry-run mode; the target is not scaled")
+	} else {
+		r.setCondition(workloadSchedule, ConditionTypeReady, metav1.ConditionTrue, "Reconciled", "Successfully reconciled")
+	}
 	r.setCondition(workloadSchedule, ConditionTypeSynced, metav1.ConditionTrue, "Synced", "Successfully synced with World Time API")
 
+	// Publish the state to running pods; failing to do so must not hold up scaling
+	switch err := r.syncStateConfigMap(ctx, workloadSchedule, currentTime.Location()); {
+	case errors.Is(err, errStateConfigMapNotOwned):
+		if !meta.IsStatusConditionFalse(workloadSchedule.Status.Conditions, ConditionTypeStatePublished) {
+			r.recordEvent(workloadSchedule, corev1.EventTypeWarning, "StateConfigMapNotOwned",
+				"Not publishing the schedule state: %v", err)
+		}
+		r.setCondition(workloadSchedule, ConditionTypeStatePublished, metav1.ConditionFalse, "ConfigMapNotOwned", err.Error())
+	case err != nil:
+		log.Error(err, "Failed to sync state ConfigMap")
+	default:
+		meta.RemoveStatusCondition(&workloadSchedule.Status.Conditions, ConditionTypeStatePublished)
+	}
+
 	if err := r.Status().Update(ctx, workloadSchedule); err != nil {
 		log.Error(err, "Failed to update WorkloadSchedule status")
 		return ctrl.Result{}, err
 	}
+	saved.record(workloadSchedule)
 
 	log.Info("Successfully reconciled WorkloadSchedule", "scaleAction", scaleAction, "replicas", currentReplicas)
-	return ctrl.Result{RequeueAfter: RequeueInterval}, nil
+
+	// Come back sooner while a ramp is in progress so steps are applied on schedule
+	requeueAfter := RequeueInterval
+	if rampWait > 0 && rampWait < requeueAfter {
+		requeueAfter = rampWait
+	}
+	// and right at the next transition so the published state flips on time
+	if next := workloadSchedule.Status.NextTransition; next != nil {
+		if untilNext := next.Sub(currentTime); untilNext > 0 && untilNext < requeueAfter {
+			requeueAfter = untilNext
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // getCurrentTime fetches the current time from worldtimeapi.org for the given timezone
 func (r *WorkloadScheduleReconciler) getCurrentTime(ctx context.Context, timezone string) (time.Time, error) {
+	return fetchCurrentTime(ctx, r.HTTPClient, timezone)
+}
+
+// fetchCurrentTime fetches the current time from worldtimeapi.org with the given client, or with a
+// default client when it is nil. Every schedule kind evaluates its window against this time.
+func fetchCurrentTime(ctx context.Context, httpClient *http.Client, timezone string) (time.Time, error) {
 	url := fmt.Sprintf("%s/%s", WorldTimeAPIURL, timezone)
 
-	httpClient := r.HTTPClient
 	if httpClient == nil {
 		httpClient = &http.Client{Timeout: 10 * time.Second}
 	}
 
+	start := time.Now()
+	defer func() { timeAPIRequestDuration.Observe(time.Since(start).Seconds()) }()
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return time.Time{}, fmt.Errorf("failed to create request: %w", err)
@@ -227,22 +419,23 @@ func (r *WorkloadScheduleReconciler) getCurrentTime(ctx context.Context, timezon
 	return parsedTime, nil
 }
 
-// isWithinActiveWindow checks if the current time is within the active window [startHour, endHour)
-func (r *WorkloadScheduleReconciler) isWithinActiveWindow(currentTime time.Time, startHour, endHour int) bool {
-	currentHour := currentTime.Hour()
-	return currentHour >= startHour && currentHour < endHour
-}
-
-// scaleDeployment scales the target deployment to the desired number of replicas
-func (r *WorkloadScheduleReconciler) scaleDeployment(ctx context.Context, namespace, deploymentName string, desiredReplicas int32) (string, int32, error) {
+// scaleDeployment scales the target deployment toward the desired number of replicas.
+// When the schedule has a RampStrategy only one step is applied per call and the ramp
+// progress is recorded in the schedule status; the returned duration is the wait before
+// the next step (zero when no ramp is in progress). Every change is recorded in the status
+// history with the given trigger.
+func (r *WorkloadScheduleReconciler) scaleDeployment(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule,
+	desiredReplicas int32, trigger infrav1alpha1.ScaleTrigger) (string, int32, time.Duration, error) {
 	log := logf.FromContext(ctx)
+	namespace, deploymentName := ws.Spec.TargetNamespace, ws.Spec.TargetDeployment
 
 	deployment := &appsv1.Deployment{}
 	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: deploymentName}, deployment); err != nil {
 		if apierrors.IsNotFound(err) {
-			return "deployment not found", 0, fmt.Errorf("deployment %s/%s not found", namespace, deploymentName)
+			r.recordEvent(ws, corev1.EventTypeWarning, "TargetNotFound", "Target deployment %s/%s not found", namespace, deploymentName)
+			return "deployment not found", 0, 0, fmt.Errorf("deployment %s/%s not found", namespace, deploymentName)
 		}
-		return "error", 0, fmt.Errorf("failed to get deployment: %w", err)
+		return "error", 0, 0, fmt.Errorf("failed to get deployment: %w", err)
 	}
 
 	currentReplicas := int32(0)
@@ -250,47 +443,164 @@ func (r *WorkloadScheduleReconciler) scaleDeployment(ctx context.Context, namesp
 		currentReplicas = *deployment.Spec.Replicas
 	}
 
+	ws.Status.AvailableReplicas = deployment.Status.AvailableReplicas
+
+	// A dry run reports the final target; ramps and drains depend on the replicas actually changing
+	if r.isDryRun(ws) {
+		ws.Status.Ramp, ws.Status.Drain = nil, nil
+		if currentReplicas == desiredReplicas {
+			return fmt.Sprintf("dry run, no change needed (replicas=%d)", desiredReplicas), currentReplicas, 0, nil
+		}
+		action := fmt.Sprintf("dry run, would scale from %d to %d", currentReplicas, desiredReplicas)
+		// Only announce a decision once rather than on every reconcile
+		if ws.Status.LastScaleAction != action {
+			r.recordTargetEvent(ctx, ws, deployment, corev1.EventTypeNormal, "WouldScale",
+				"Dry run: would scale deployment %s/%s from %d to %d (%s)", namespace, deploymentName,
+				currentReplicas, desiredReplicas, trigger)
+		}
+		return action, currentReplicas, 0, nil
+	}
+
+	// With GitOps actuation a GitOps tool applies the replicas, so the final target is published as-is
+	if actuationMethod(ws) == infrav1alpha1.ActuationMethodGitOps {
+		ws.Status.Ramp, ws.Status.Drain = nil, nil
+		action, err := r.publishDesiredReplicas(ctx, ws, deployment, currentReplicas, desiredReplicas)
+		return action, currentReplicas, 0, err
+	}
+
+	// A break-glass override keeps replicas raised by hand until it expires
+	if r.breakGlassHolds(ws, deployment, time.Now()) && desiredReplicas < currentReplicas {
+		ws.Status.Ramp, ws.Status.Drain = nil, nil
+		return fmt.Sprintf("break-glass override, holding replicas=%d instead of scaling to %d", currentReplicas,
+			desiredReplicas), currentReplicas, 0, nil
+	}
+
+	previousRamp := ws.Status.Ramp
+	stepReplicas, ramp, rampWait := nextRampStep(ws.Spec.RampStrategy, previousRamp, currentReplicas, desiredReplicas, time.Now())
+	ws.Status.Ramp = ramp
+
+	// Hold a scale-down until in-flight work has drained or the grace period runs out
+	drainNote := ""
+	if stepReplicas >= currentReplicas || ws.Spec.DrainGate == nil {
+		ws.Status.Drain = nil
+	} else {
+		drain := r.evaluateDrainGate(ctx, ws, deployment, time.Now())
+		if !drain.proceed {
+			// Keep the ramp where it was so the same step is attempted once the pods are idle
+			ws.Status.Ramp = previousRamp
+			if drain.waited == 0 {
+				r.recordEvent(ws, corev1.EventTypeNormal, "DrainWaiting",
+					"Waiting for %d busy pod(s) of %s/%s to become idle before scaling down", drain.busyPods, namespace, deploymentName)
+			}
+			return fmt.Sprintf("waiting for %d busy pod(s) to drain before scaling from %d to %d",
+				drain.busyPods, currentReplicas, stepReplicas), currentReplicas, DrainPollInterval, nil
+		}
+		if drain.forced {
+			drainNote = fmt.Sprintf(" (drain forced after %s with %d busy pod(s))", drain.waited.Round(time.Second), drain.busyPods)
+			r.recordEvent(ws, corev1.EventTypeWarning, "DrainForced",
+				"Grace period expired with %d busy pod(s); scaling %s/%s down from %d to %d",
+				drain.busyPods, namespace, deploymentName, currentReplicas, stepReplicas)
+		} else {
+			r.recordEvent(ws, corev1.EventTypeNormal, "Drained",
+				"Pods of %s/%s are idle; scaling down from %d to %d", namespace, deploymentName, currentReplicas, stepReplicas)
+		}
+	}
+
 	// Check if scaling is needed
-	if currentReplicas == desiredReplicas {
-		return fmt.Sprintf("no change needed (replicas=%d)", desiredReplicas), desiredReplicas, nil
+	if currentReplicas == stepReplicas {
+		if ramp != nil {
+			return fmt.Sprintf("ramping from %d to %d, waiting for next step (replicas=%d)",
+				ramp.FromReplicas, ramp.TargetReplicas, currentReplicas), currentReplicas, rampWait, nil
+		}
+		return fmt.Sprintf("no change needed (replicas=%d)", desiredReplicas), desiredReplicas, 0, nil
 	}
 
 	// Scale the deployment
 	log.Info("Scaling deployment", "namespace", namespace, "deployment", deploymentName,
-		"from", currentReplicas, "to", desiredReplicas)
-
-	deployment.Spec.Replicas = &desiredReplicas
-	if err := r.Update(ctx, deployment); err != nil {
-		return "scale failed", currentReplicas, fmt.Errorf("failed to scale deployment: %w", err)
+		"from", currentReplicas, "to", stepReplicas, "target", desiredReplicas)
+
+	// Only the replicas are written, through the scale subresource, so concurrent changes to the rest
+	// of the deployment are not clobbered. The resource version makes a stale decision fail with a
+	// conflict instead of overwriting replicas that changed since they were read.
+	scale := &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: deploymentName, ResourceVersion: deployment.ResourceVersion},
+		Spec:       autoscalingv1.ScaleSpec{Replicas: stepReplicas},
+	}
+	if err := r.SubResource("scale").Update(ctx, deployment, client.WithSubResourceBody(scale),
+		client.FieldOwner(infrav1alpha1.FieldManager)); err != nil {
+		// Keep the previous progress so the step is retried on the next reconcile
+		ws.Status.Ramp = previousRamp
+		return "scale failed", currentReplicas, 0, fmt.Errorf("failed to scale deployment: %w", err)
 	}
 
-	return fmt.Sprintf("scaled from %d to %d", currentReplicas, desiredReplicas), desiredReplicas, nil
-}
+	ws.Status.Drain = nil
 
-// ensureNamespace creates the namespace if it doesn't exist
-func (r *WorkloadScheduleReconciler) ensureNamespace(ctx context.Context, namespace string) error {
-	ns := &corev1.Namespace{}
-	err := r.Get(ctx, types.NamespacedName{Name: namespace}, ns)
-	if err == nil {
-		return nil // Namespace exists
+	reason, direction := "ScaledUp", "up"
+	if stepReplicas < currentReplicas {
+		reason, direction = "ScaledDown", "down"
 	}
+	scheduleScaleActions.WithLabelValues(ws.Namespace, ws.Name, direction).Inc()
+	recordScaleHistory(ws, infrav1alpha1.ScaleHistoryEntry{
+		Time:         metav1.Now(),
+		FromReplicas: currentReplicas,
+		ToReplicas:   stepReplicas,
+		Reason:       reason,
+		Trigger:      trigger,
+		TimeSource:   TimeSourceWorldTimeAPI,
+	})
+	r.recordTargetEvent(ctx, ws, deployment, corev1.EventTypeNormal, reason,
+		"Scaled deployment %s/%s %s from %d to %d (target %d)", namespace, deploymentName, direction,
+		currentReplicas, stepReplicas, desiredReplicas)
 
-	if !apierrors.IsNotFound(err) {
-		return fmt.Errorf("failed to check namespace: %w", err)
+	if ramp != nil {
+		return fmt.Sprintf("ramping from %d to %d, scaled from %d to %d%s", ramp.FromReplicas, ramp.TargetReplicas,
+			currentReplicas, stepReplicas, drainNote), stepReplicas, rampWait, nil
 	}
+	return fmt.Sprintf("scaled from %d to %d%s", currentReplicas, desiredReplicas, drainNote), desiredReplicas, 0, nil
+}
 
-	// Create namespace
-	ns = &corev1.Namespace{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: namespace,
-		},
-	}
+// isDryRun reports whether decisions for the schedule must not be applied to its target
+func (r *WorkloadScheduleReconciler) isDryRun(ws *infrav1alpha1.WorkloadSchedule) bool {
+	return r.DryRun || ws.Spec.Mode == infrav1alpha1.ScheduleModeDryRun
+}
 
-	if err := r.Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
-		return fmt.Errorf("failed to create namespace: %w", err)
+// standDown reports why the schedule cannot act on its target with Ready=False, records a Warning
+// Event when the reason is new, and checks again after RequeueInterval
+func (r *WorkloadScheduleReconciler) standDown(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule,
+	reason, message string) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if ready := meta.FindStatusCondition(ws.Status.Conditions, ConditionTypeReady); ready == nil || ready.Reason != reason {
+		r.recordEvent(ws, corev1.EventTypeWarning, reason, message)
+	}
+	log.Info("Schedule cannot act on its target", "reason", reason, "message", message)
+	// Argo CD must not stay paused for a schedule that no longer holds the target down
+	if err := r.releaseArgoCDPause(ctx, ws); err != nil {
+		log.Error(err, "Failed to lift the Argo CD Application pause")
+		return ctrl.Result{}, err
 	}
+	r.setCondition(ws, ConditionTypeReady, metav1.ConditionFalse, reason, message)
+	if err := r.Status().Update(ctx, ws); err != nil {
+		log.Error(err, "Failed to update WorkloadSchedule status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: RequeueInterval}, nil
+}
 
-	return nil
+// watchesNamespace reports whether objects in the namespace are visible to the operator
+func (r *WorkloadScheduleReconciler) watchesNamespace(namespace string) bool {
+	return len(r.WatchNamespaces) == 0 || slices.Contains(r.WatchNamespaces, namespace)
+}
+
+// namespaceExists reports whether the namespace exists
+func (r *WorkloadScheduleReconciler) namespaceExists(ctx context.Context, namespace string) (bool, error) {
+	if err := r.Get(ctx, types.NamespacedName{Name: namespace}, &corev1.Namespace{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check namespace: %w", err)
+	}
+	return true, nil
 }
 
 // cleanupResources performs cleanup when the WorkloadSchedule is deleted
@@ -298,12 +608,58 @@ func (r *WorkloadScheduleReconciler) cleanupResources(ctx context.Context, workl
 	log := logf.FromContext(ctx)
 	log.Info("Cleaning up resources for WorkloadSchedule", "name", workloadSchedule.Name)
 
+	if err := r.deleteStateConfigMap(ctx, workloadSchedule); err != nil {
+		return err
+	}
+	deleteScheduleMetrics(workloadSchedule)
+
+	// Never leave the Application paused once the schedule is gone. An Application outside the
+	// watched namespaces cannot have been paused by the operator.
+	if err := r.releaseArgoCDPause(ctx, workloadSchedule); err != nil {
+		return err
+	}
+	if actuation := workloadSchedule.Spec.Actuation; actuation != nil && actuation.ArgoCDApplication != nil &&
+		r.watchesNamespace(argoCDApplicationNamespace(actuation.ArgoCDApplication)) {
+		if err := r.syncArgoCDPause(ctx, workloadSchedule, false); err != nil &&
+			!apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return err
+		}
+	}
+
 	// Optionally scale the deployment back to a default value (e.g., 1) on deletion
 	// For now, we just log the cleanup
 	log.Info("Cleanup completed")
 	return nil
 }
 
+// recordEvent emits an Event on the WorkloadSchedule when a recorder is configured
+func (r *WorkloadScheduleReconciler) recordEvent(ws *infrav1alpha1.WorkloadSchedule, eventType, reason, messageFmt string, args ...interface{}) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Eventf(ws, eventType, reason, messageFmt, args...)
+}
+
+// recordTargetEvent emits an Event on the WorkloadSchedule and on its target Deployment. When the
+// deployment is not passed in, it is looked up and the Event is only emitted on the schedule if it
+// does not exist.
+func (r *WorkloadScheduleReconciler) recordTargetEvent(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule,
+	deployment *appsv1.Deployment, eventType, reason, messageFmt string, args ...interface{}) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Eventf(ws, eventType, reason, messageFmt, args...)
+
+	if deployment == nil {
+		deployment = &appsv1.Deployment{}
+		key := types.NamespacedName{Namespace: ws.Spec.TargetNamespace, Name: ws.Spec.TargetDeployment}
+		if err := r.Get(ctx, key, deployment); err != nil {
+			return
+		}
+	}
+	r.Recorder.Eventf(deployment, eventType, reason, messageFmt, args...)
+}
+
 // setCondition sets a condition on the WorkloadSchedule status
 func (r *WorkloadScheduleReconciler) setCondition(ws *infrav1alpha1.WorkloadSchedule, conditionType string, status metav1.ConditionStatus, reason, message string) {
 	meta.SetStatusCondition(&ws.Status.Conditions, metav1.Condition{
@@ -315,10 +671,23 @@ func (r *WorkloadScheduleReconciler) setCondition(ws *infrav1alpha1.WorkloadSche
 	})
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// SetupWithManager sets up the controller with the Manager. It queries the shared indexes in
+// internal/index, which must already be registered.
 func (r *WorkloadScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &infrav1alpha1.WorkloadSchedule{},
+		TemplateIndexField, indexByTemplate); err != nil {
+		return err
+	}
+
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.WorkloadSchedule{}).
-		Named("workloadschedule").
-		Complete(r)
+		Watches(&infrav1alpha1.WorkloadSchedule{}, handler.EnqueueRequestsFromMapFunc(r.findSchedulesForSameTarget)).
+		Watches(&infrav1alpha1.ScheduleTargetGrant{}, handler.EnqueueRequestsFromMapFunc(r.findSchedulesForGrant)).
+		Watches(&infrav1alpha1.ScheduleTemplate{}, handler.EnqueueRequestsFromMapFunc(r.findSchedulesForTemplate))
+	// A namespace-scoped installation has no access to cluster-scoped templates
+	if len(r.WatchNamespaces) == 0 {
+		builder = builder.Watches(&infrav1alpha1.ClusterScheduleTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.findSchedulesForTemplate))
+	}
+	return builder.Named("workloadschedule").Complete(r)
 }
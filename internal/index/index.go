@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package index holds the WorkloadSchedule field indexes shared by the controllers and webhooks.
+package index
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+const (
+	// TargetField indexes WorkloadSchedules by "<targetNamespace>/<targetDeployment>"
+	TargetField = ".spec.target"
+
+	// TargetNamespaceField indexes WorkloadSchedules by spec.targetNamespace
+	TargetNamespaceField = "spec.targetNamespace"
+
+	// ApplicationNamespaceField indexes WorkloadSchedules by the namespace of the Argo CD Application
+	// they pause; schedules without one are not indexed
+	ApplicationNamespaceField = "spec.actuation.argoCDApplication.namespace"
+)
+
+// SetupWithManager registers the shared indexes in the manager's cache. It must be called once per
+// manager, before the controllers and webhooks that query them are set up.
+func SetupWithManager(mgr ctrl.Manager) error {
+	indexer := mgr.GetFieldIndexer()
+	if err := indexer.IndexField(context.Background(), &infrav1alpha1.WorkloadSchedule{},
+		TargetField, ByTarget); err != nil {
+		return err
+	}
+	if err := indexer.IndexField(context.Background(), &infrav1alpha1.WorkloadSchedule{},
+		TargetNamespaceField, ByTargetNamespace); err != nil {
+		return err
+	}
+	return indexer.IndexField(context.Background(), &infrav1alpha1.WorkloadSchedule{},
+		ApplicationNamespaceField, ByApplicationNamespace)
+}
+
+// TargetKey returns the TargetField key for a target deployment
+func TargetKey(namespace, deployment string) string {
+	return namespace + "/" + deployment
+}
+
+// ByTarget is the field indexer for TargetField
+func ByTarget(obj client.Object) []string {
+	ws, ok := obj.(*infrav1alpha1.WorkloadSchedule)
+	if !ok {
+		return nil
+	}
+	return []string{TargetKey(ws.Spec.TargetNamespace, ws.Spec.TargetDeployment)}
+}
+
+// ByTargetNamespace is the field indexer for TargetNamespaceField
+func ByTargetNamespace(obj client.Object) []string {
+	ws, ok := obj.(*infrav1alpha1.WorkloadSchedule)
+	if !ok {
+		return nil
+	}
+	return []string{ws.Spec.TargetNamespace}
+}
+
+// ByApplicationNamespace is the field indexer for ApplicationNamespaceField
+func ByApplicationNamespace(obj client.Object) []string {
+	ws, ok := obj.(*infrav1alpha1.WorkloadSchedule)
+	if !ok || ws.Spec.Actuation == nil || ws.Spec.Actuation.ArgoCDApplication == nil {
+		return nil
+	}
+	return []string{ws.Spec.Actuation.ArgoCDApplication.ApplicationNamespace()}
+}
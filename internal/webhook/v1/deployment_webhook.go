@@ -0,0 +1,234 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+const (
+	// DeploymentWebhookPath is where the Deployment scale validator is served
+	DeploymentWebhookPath = "/validate-apps-v1-deployment"
+
+	// BreakGlassAnnotation on a Deployment allows replica increases outside the active window of an
+	// enforcing schedule. Its value should explain why and is recorded in an Event.
+	BreakGlassAnnotation = infrav1alpha1.BreakGlassAnnotation
+)
+
+// log is for logging in this package.
+var deploymentlog = logf.Log.WithName("deployment-webhook")
+
+// SetupDeploymentWebhookWithManager registers the Deployment scale validator in the manager. When
+// dryRun is set, as with the manager's --dry-run flag, no schedule is enforced. Requests from
+// controllerUsername, the user the controller authenticates as, are never blocked. It queries the
+// index.TargetNamespaceField index, which must already be registered.
+func SetupDeploymentWebhookWithManager(mgr ctrl.Manager, dryRun bool, controllerUsername string) error {
+	mgr.GetWebhookServer().Register(DeploymentWebhookPath, &webhook.Admission{Handler: &DeploymentScaleValidator{
+		Client:             mgr.GetClient(),
+		Decoder:            admission.NewDecoder(mgr.GetScheme()),
+		Recorder:           mgr.GetEventRecorderFor("deployment-webhook"),
+		DryRun:             dryRun,
+		ControllerUsername: controllerUsername,
+	}})
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-apps-v1-deployment,mutating=false,failurePolicy=ignore,sideEffects=NoneOnDryRun,groups=apps,resources=deployments;deployments/scale,verbs=update,versions=v1,name=vdeployment-v1.kb.io,admissionReviewVersions=v1
+
+// DeploymentScaleValidator rejects manual replica increases of Deployments whose WorkloadSchedule
+// enforces its window while the schedule is inactive. It handles both Deployment updates and the
+// scale subresource used by `kubectl scale`, so it is a plain admission handler rather than a
+// CustomValidator bound to a single type.
+type DeploymentScaleValidator struct {
+	Client   client.Client
+	Decoder  admission.Decoder
+	Recorder record.EventRecorder
+
+	// DryRun disables enforcement for every schedule, matching a controller that does not scale
+	DryRun bool
+
+	// ControllerUsername is the user the controller authenticates as, usually its service account.
+	// Its own scaling, e.g. while pre-warming, is never blocked.
+	ControllerUsername string
+}
+
+var _ admission.Handler = &DeploymentScaleValidator{}
+
+// Handle implements admission.Handler.
+func (v *DeploymentScaleValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Update || v.DryRun {
+		return admission.Allowed("")
+	}
+
+	oldReplicas, newReplicas, err := v.replicaChange(req)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if newReplicas <= oldReplicas {
+		return admission.Allowed("")
+	}
+
+	// The controller's own scaling is never blocked. The authenticated user is checked rather than
+	// the field manager, which any client can set.
+	if v.ControllerUsername != "" && req.UserInfo.Username == v.ControllerUsername {
+		return admission.Allowed("")
+	}
+
+	schedule, err := v.enforcingSchedule(ctx, req.Namespace, req.Name)
+	if err != nil {
+		// Don't block scaling because of our own failures
+		deploymentlog.Error(err, "Failed to find WorkloadSchedule for deployment", "namespace", req.Namespace, "name", req.Name)
+		return admission.Allowed("")
+	}
+	if schedule == nil || schedule.Status.WithinActiveWindow {
+		return admission.Allowed("")
+	}
+
+	deployment := &appsv1.Deployment{}
+	if req.SubResource == "scale" {
+		if err := v.Client.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: req.Name}, deployment); err != nil {
+			deploymentlog.Error(err, "Failed to get deployment", "namespace", req.Namespace, "name", req.Name)
+			return admission.Allowed("")
+		}
+	} else if err := v.Decoder.DecodeRaw(req.Object, deployment); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	scheduleName := types.NamespacedName{Namespace: schedule.Namespace, Name: schedule.Name}.String()
+	reason := deployment.Annotations[BreakGlassAnnotation]
+	// An override the schedule already let expire is spent until it is given a new reason
+	expired := meta.FindStatusCondition(schedule.Status.Conditions, infrav1alpha1.ConditionTypeBreakGlass)
+	spent := expired != nil && expired.Status == metav1.ConditionFalse && expired.Message == reason
+	if reason != "" && !spent {
+		deploymentlog.Info("Break-glass scale-up allowed", "namespace", req.Namespace, "name", req.Name,
+			"from", oldReplicas, "to", newReplicas, "user", req.UserInfo.Username, "reason", reason)
+		if (req.DryRun == nil || !*req.DryRun) && v.Recorder != nil {
+			message := fmt.Sprintf("%s raised replicas from %d to %d outside the active window of WorkloadSchedule %s: %s",
+				req.UserInfo.Username, oldReplicas, newReplicas, scheduleName, reason)
+			v.Recorder.Event(deployment, corev1.EventTypeWarning, "BreakGlass", message)
+			v.Recorder.Event(schedule, corev1.EventTypeWarning, "BreakGlass", message)
+		}
+		return admission.Allowed("break-glass annotation set").WithWarnings(fmt.Sprintf(
+			"replicas raised outside the active window of WorkloadSchedule %s; the schedule holds them for %s, then "+
+				"scales the deployment back down", scheduleName, infrav1alpha1.BreakGlassDuration))
+	}
+	if spent {
+		return admission.Denied(fmt.Sprintf("deployment %s/%s is managed by WorkloadSchedule %s, whose break-glass "+
+			"override %q has expired; set the %s annotation to a new reason to override again",
+			req.Namespace, req.Name, scheduleName, reason, BreakGlassAnnotation))
+	}
+
+	return admission.Denied(fmt.Sprintf("deployment %s/%s is managed by WorkloadSchedule %s, which is inactive outside "+
+		"%s; replicas cannot be raised from %d to %d. Set the %s annotation on the deployment "+
+		"to a reason to override", req.Namespace, req.Name, scheduleName, activeWindows(schedule),
+		oldReplicas, newReplicas, BreakGlassAnnotation))
+}
+
+// activeWindows describes when the schedule is active, for messages
+func activeWindows(ws *infrav1alpha1.WorkloadSchedule) string {
+	if ref := ws.Spec.TemplateRef; ref != nil {
+		return fmt.Sprintf("the windows of %s %s", ref.TemplateKind(), ref.Name)
+	}
+	return fmt.Sprintf("%02d:00-%02d:00 %s", ws.Spec.StartHour, ws.Spec.EndHour, ws.Spec.Timezone)
+}
+
+// replicaChange returns the replica count before and after the request, from either a Deployment
+// or a Scale object depending on the subresource
+func (v *DeploymentScaleValidator) replicaChange(req admission.Request) (int32, int32, error) {
+	if req.SubResource == "scale" {
+		oldScale, newScale := &autoscalingv1.Scale{}, &autoscalingv1.Scale{}
+		if err := v.Decoder.DecodeRaw(req.OldObject, oldScale); err != nil {
+			return 0, 0, err
+		}
+		if err := v.Decoder.DecodeRaw(req.Object, newScale); err != nil {
+			return 0, 0, err
+		}
+		return oldScale.Spec.Replicas, newScale.Spec.Replicas, nil
+	}
+
+	oldDeployment, newDeployment := &appsv1.Deployment{}, &appsv1.Deployment{}
+	if err := v.Decoder.DecodeRaw(req.OldObject, oldDeployment); err != nil {
+		return 0, 0, err
+	}
+	if err := v.Decoder.DecodeRaw(req.Object, newDeployment); err != nil {
+		return 0, 0, err
+	}
+	return deploymentReplicas(oldDeployment), deploymentReplicas(newDeployment), nil
+}
+
+// enforcingSchedule returns the schedule that applies to the deployment when its ScalePolicy is
+// Enforce, or nil when the deployment is not scheduled, or its schedule allows manual scaling or
+// only runs in dry-run mode. Schedules without a ScheduleTargetGrant for the namespace are ignored.
+func (v *DeploymentScaleValidator) enforcingSchedule(ctx context.Context, namespace,
+	name string) (*infrav1alpha1.WorkloadSchedule, error) {
+	schedules := &infrav1alpha1.WorkloadScheduleList{}
+	if err := v.Client.List(ctx, schedules, client.MatchingFields{index.TargetNamespaceField: namespace}); err != nil {
+		return nil, fmt.Errorf("failed to list WorkloadSchedules: %w", err)
+	}
+	permitted, err := permittedSchedules(ctx, v.Client, namespace, schedules.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	var targeting []*infrav1alpha1.WorkloadSchedule
+	for i := range permitted {
+		if permitted[i].Spec.TargetDeployment == name {
+			targeting = append(targeting, &permitted[i])
+		}
+	}
+	if len(targeting) == 0 {
+		return nil, nil
+	}
+
+	schedule := selectSchedule(targeting)
+	if schedule.Spec.ScalePolicy != infrav1alpha1.ScalePolicyEnforce || schedule.Spec.Mode == infrav1alpha1.ScheduleModeDryRun {
+		return nil, nil
+	}
+	return schedule, nil
+}
+
+// deploymentReplicas returns the desired replicas of a Deployment, which default to 1 when unset
+func deploymentReplicas(deployment *appsv1.Deployment) int32 {
+	if deployment.Spec.Replicas == nil {
+		return 1
+	}
+	return *deployment.Spec.Replicas
+}
+
+// ServiceAccountUsername returns the username a service account authenticates as
+func ServiceAccountUsername(namespace, name string) string {
+	return "system:serviceaccount:" + namespace + ":" + name
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("Deployment Webhook", func() {
+	var (
+		validator  *DeploymentScaleValidator
+		recorder   *record.FakeRecorder
+		schedule   *infrav1alpha1.WorkloadSchedule
+		deployment *appsv1.Deployment
+	)
+
+	raw := func(obj runtime.Object) runtime.RawExtension {
+		data, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+		return runtime.RawExtension{Raw: data}
+	}
+
+	withReplicas := func(replicas int32) *appsv1.Deployment {
+		updated := deployment.DeepCopy()
+		updated.Spec.Replicas = &replicas
+		return updated
+	}
+
+	updateRequest := func(oldObj, newObj *appsv1.Deployment) admission.Request {
+		return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Update,
+			Namespace: "demo",
+			Name:      "demo-deployment",
+			OldObject: raw(oldObj),
+			Object:    raw(newObj),
+		}}
+	}
+
+	scaleRequest := func(from, to int32) admission.Request {
+		scale := func(replicas int32) *autoscalingv1.Scale {
+			return &autoscalingv1.Scale{
+				ObjectMeta: metav1.ObjectMeta{Name: "demo-deployment", Namespace: "demo"},
+				Spec:       autoscalingv1.ScaleSpec{Replicas: replicas},
+			}
+		}
+		return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation:   admissionv1.Update,
+			Namespace:   "demo",
+			Name:        "demo-deployment",
+			SubResource: "scale",
+			OldObject:   raw(scale(from)),
+			Object:      raw(scale(to)),
+		}}
+	}
+
+	newValidator := func() {
+		testScheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(testScheme)).To(Succeed())
+		recorder = record.NewFakeRecorder(10)
+		validator = &DeploymentScaleValidator{
+			Client:   newFakeClient(schedule, deployment),
+			Decoder:  admission.NewDecoder(testScheme),
+			Recorder: recorder,
+		}
+	}
+
+	BeforeEach(func() {
+		schedule = newSchedule("business-hours", "demo", "demo-deployment", false)
+		schedule.Spec.ScalePolicy = infrav1alpha1.ScalePolicyEnforce
+		deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "demo-deployment", Namespace: "demo"}}
+		deployment.Spec.Replicas = new(int32)
+		newValidator()
+	})
+
+	It("Should reject replica increases outside the active window", func() {
+		response := validator.Handle(ctx, updateRequest(deployment, withReplicas(3)))
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("default/business-hours"))
+		Expect(response.Result.Message).To(ContainSubstring(BreakGlassAnnotation))
+
+		response = validator.Handle(ctx, scaleRequest(0, 2))
+		Expect(response.Allowed).To(BeFalse())
+	})
+
+	It("Should allow decreases, other edits, active windows and schedules that do not enforce", func() {
+		Expect(validator.Handle(ctx, scaleRequest(3, 1)).Allowed).To(BeTrue())
+		Expect(validator.Handle(ctx, updateRequest(deployment, withReplicas(0))).Allowed).To(BeTrue())
+
+		schedule.Status.WithinActiveWindow = true
+		newValidator()
+		Expect(validator.Handle(ctx, scaleRequest(0, 2)).Allowed).To(BeTrue())
+
+		schedule.Status.WithinActiveWindow = false
+		schedule.Spec.ScalePolicy = infrav1alpha1.ScalePolicyAllow
+		newValidator()
+		Expect(validator.Handle(ctx, scaleRequest(0, 2)).Allowed).To(BeTrue())
+	})
+
+	It("Should not enforce schedules in dry-run mode", func() {
+		schedule.Spec.Mode = infrav1alpha1.ScheduleModeDryRun
+		newValidator()
+		Expect(validator.Handle(ctx, scaleRequest(0, 2)).Allowed).To(BeTrue())
+
+		schedule.Spec.Mode = infrav1alpha1.ScheduleModeActive
+		newValidator()
+		validator.DryRun = true
+		Expect(validator.Handle(ctx, scaleRequest(0, 2)).Allowed).To(BeTrue())
+	})
+
+	It("Should not enforce schedules from namespaces without a grant", func() {
+		schedule.Namespace = "team-b"
+		newValidator()
+		Expect(validator.Handle(ctx, scaleRequest(0, 2)).Allowed).To(BeTrue())
+	})
+
+	It("Should never block the controller's own scaling", func() {
+		validator.ControllerUsername = ServiceAccountUsername("workload-schedule-operator-system", "controller-manager")
+		request := scaleRequest(0, 2)
+		request.UserInfo.Username = validator.ControllerUsername
+		Expect(validator.Handle(ctx, request).Allowed).To(BeTrue())
+
+		By("not trusting the field manager, which any client can set")
+		request = scaleRequest(0, 2)
+		request.UserInfo.Username = "jane"
+		request.Options = raw(&metav1.UpdateOptions{FieldManager: infrav1alpha1.FieldManager})
+		Expect(validator.Handle(ctx, request).Allowed).To(BeFalse())
+	})
+
+	It("Should allow a break-glass scale-up and record it as an Event", func() {
+		deployment.Annotations = map[string]string{BreakGlassAnnotation: "incident 42"}
+		newValidator()
+
+		response := validator.Handle(ctx, scaleRequest(0, 2))
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Warnings).To(ContainElement(ContainSubstring("default/business-hours")))
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(<-recorder.Events).To(And(ContainSubstring("BreakGlass"), ContainSubstring("incident 42")))
+
+		By("not recording Events for dry-run requests")
+		dryRun := true
+		request := updateRequest(deployment, withReplicas(2))
+		request.DryRun = &dryRun
+		Expect(validator.Handle(ctx, request).Allowed).To(BeTrue())
+		Expect(recorder.Events).To(HaveLen(1))
+	})
+
+	It("Should deny a scale-up once the schedule let the break-glass override expire", func() {
+		deployment.Annotations = map[string]string{BreakGlassAnnotation: "incident 42"}
+		schedule.Status.Conditions = []metav1.Condition{{
+			Type: infrav1alpha1.ConditionTypeBreakGlass, Status: metav1.ConditionFalse, Reason: "Expired", Message: "incident 42",
+		}}
+		newValidator()
+
+		response := validator.Handle(ctx, scaleRequest(0, 2))
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("has expired"))
+
+		By("allowing it again with a new reason")
+		deployment.Annotations[BreakGlassAnnotation] = "incident 43"
+		newValidator()
+		Expect(validator.Handle(ctx, scaleRequest(0, 2)).Allowed).To(BeTrue())
+	})
+})
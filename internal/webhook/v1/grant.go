@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// permittedSchedules drops the schedules from other namespaces that no ScheduleTargetGrant in the
+// target namespace permits, so they cannot affect its workloads. When the grants cannot be read,
+// only the schedules from the namespace itself are returned along with the error.
+func permittedSchedules(ctx context.Context, c client.Client, namespace string,
+	schedules []infrav1alpha1.WorkloadSchedule) ([]infrav1alpha1.WorkloadSchedule, error) {
+	grants := &infrav1alpha1.ScheduleTargetGrantList{}
+	var err error
+	for i := range schedules {
+		if schedules[i].Namespace != namespace {
+			if err = c.List(ctx, grants, client.InNamespace(namespace)); err != nil {
+				err = fmt.Errorf("failed to list ScheduleTargetGrants: %w", err)
+			}
+			break
+		}
+	}
+
+	permitted := make([]infrav1alpha1.WorkloadSchedule, 0, len(schedules))
+	for i := range schedules {
+		if infrav1alpha1.TargetPermitted(&schedules[i], grants.Items) {
+			permitted = append(permitted, schedules[i])
+		}
+	}
+	return permitted, err
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch
+
+// ownerDeployment resolves the Deployment controlling the pod through its ReplicaSet. The name is
+// empty when the pod is not managed by a Deployment. resolved is false when the pod is owned by a
+// ReplicaSet that could not be read, typically because the cache has not observed it yet.
+func (d *PodCustomDefaulter) ownerDeployment(ctx context.Context, pod *corev1.Pod) (name string, resolved bool) {
+	podOwner := metav1.GetControllerOf(pod)
+	if podOwner == nil || podOwner.Kind != "ReplicaSet" || podOwner.APIVersion != appsv1.SchemeGroupVersion.String() {
+		return "", true
+	}
+
+	replicaSet := &appsv1.ReplicaSet{}
+	if err := d.Client.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: podOwner.Name}, replicaSet); err != nil {
+		podlog.Info("Failed to get owning ReplicaSet, falling back to Deployment selectors",
+			"replicaSet", podOwner.Name, "namespace", pod.Namespace, "error", err.Error())
+		return "", false
+	}
+
+	rsOwner := metav1.GetControllerOf(replicaSet)
+	if rsOwner == nil || rsOwner.Kind != "Deployment" || rsOwner.APIVersion != appsv1.SchemeGroupVersion.String() {
+		return "", true
+	}
+	return rsOwner.Name, true
+}
+
+// selectedByTarget reports whether the pod matches the selector of the schedule's target Deployment
+func (d *PodCustomDefaulter) selectedByTarget(ctx context.Context, pod *corev1.Pod, ws *infrav1alpha1.WorkloadSchedule) bool {
+	deployment := &appsv1.Deployment{}
+	if err := d.Client.Get(ctx, types.NamespacedName{Namespace: ws.Spec.TargetNamespace, Name: ws.Spec.TargetDeployment}, deployment); err != nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil || selector.Empty() {
+		return false
+	}
+	return selector.Matches(labels.Set(pod.Labels))
+}
+
+// schedulesForPod narrows the schedules targeting the pod's namespace to those whose target
+// Deployment owns the pod. Owner references are authoritative; the Deployment's selector is
+// only consulted when the owning ReplicaSet cannot be resolved.
+func (d *PodCustomDefaulter) schedulesForPod(ctx context.Context, pod *corev1.Pod,
+	schedules []infrav1alpha1.WorkloadSchedule) []*infrav1alpha1.WorkloadSchedule {
+	deploymentName, resolved := d.ownerDeployment(ctx, pod)
+	if resolved && deploymentName == "" {
+		return nil
+	}
+
+	var matching []*infrav1alpha1.WorkloadSchedule
+	for i := range schedules {
+		ws := &schedules[i]
+		if resolved && ws.Spec.TargetDeployment == deploymentName ||
+			!resolved && d.selectedByTarget(ctx, pod, ws) {
+			matching = append(matching, ws)
+		}
+	}
+	return matching
+}
@@ -19,23 +19,60 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"time"
+	// Embed the IANA timezone database so times are rendered in the schedule's zone on any image
+	_ "time/tzdata"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
 )
 
 const (
 	// ActiveLabel is the label injected into Pods
 	ActiveLabel = "schedule.illumin.com/active"
 
+	// InjectAnnotation opts a Pod or Namespace out of mutation when set to "false". It is also
+	// honoured as a label, which the webhook's objectSelector and namespaceSelector match on so
+	// opted-out objects are not even sent to the webhook.
+	InjectAnnotation = "schedule.illumin.com/inject"
+
+	// AppliedScheduleAnnotation names the WorkloadSchedule ("<namespace>/<name>") applied to a Pod
+	AppliedScheduleAnnotation = "schedule.illumin.com/schedule"
+
 	// ActiveEnvVar is the environment variable injected into containers
 	ActiveEnvVar = "WORKLOAD_SCHEDULE_ACTIVE"
+
+	// ScheduleNameEnvVar carries the name of the applied schedule
+	ScheduleNameEnvVar = "WORKLOAD_SCHEDULE_NAME"
+
+	// WindowEndEnvVar and WindowEndAnnotation carry the end of the current or next active window (RFC3339)
+	WindowEndEnvVar     = "WORKLOAD_SCHEDULE_WINDOW_END"
+	WindowEndAnnotation = "schedule.illumin.com/window-end"
+
+	// NextTransitionEnvVar and NextTransitionAnnotation carry the next active/inactive transition (RFC3339)
+	NextTransitionEnvVar     = "WORKLOAD_SCHEDULE_NEXT_TRANSITION"
+	NextTransitionAnnotation = "schedule.illumin.com/next-transition"
+
+	// TimezoneEnvVar and TimezoneAnnotation carry the schedule's timezone
+	TimezoneEnvVar     = "WORKLOAD_SCHEDULE_TIMEZONE"
+	TimezoneAnnotation = "schedule.illumin.com/timezone"
+
+	// StateVolumeName is the projected volume exposing the schedule's live state ConfigMap
+	StateVolumeName = "workload-schedule-state"
+
+	// StateMountPath is where the state volume is mounted in every container
+	StateMountPath = "/etc/workload-schedule"
 )
 
 // log is for logging in this package.
@@ -48,13 +85,16 @@ type PodCustomDefaulter struct {
 
 var _ webhook.CustomDefaulter = &PodCustomDefaulter{}
 
-// SetupPodWebhookWithManager registers the webhook for Pod in the manager.
+// SetupPodWebhookWithManager registers the webhook for Pod in the manager. It queries the
+// index.TargetNamespaceField index, which must already be registered.
 func SetupPodWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).For(&corev1.Pod{}).
 		WithDefaulter(&PodCustomDefaulter{Client: mgr.GetClient()}).
 		Complete()
 }
 
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
 // +kubebuilder:webhook:path=/mutate--v1-pod,mutating=true,failurePolicy=ignore,sideEffects=None,groups="",resources=pods,verbs=create,versions=v1,name=mpod-v1.kb.io,admissionReviewVersions=v1
 
 // Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind Pod.
@@ -74,30 +114,50 @@ func (d *PodCustomDefaulter) Default(ctx context.Context, obj runtime.Object) er
 		return nil
 	}
 
-	// Find WorkloadSchedule that targets this namespace
+	if optedOut(&pod.ObjectMeta) {
+		podlog.Info("Pod opted out of injection, skipping mutation", "name", pod.GetName(), "namespace", namespace)
+		return nil
+	}
+	if d.namespaceOptedOut(ctx, namespace) {
+		podlog.Info("Namespace opted out of injection, skipping mutation", "namespace", namespace)
+		return nil
+	}
+
+	// Find WorkloadSchedules that target this namespace
 	workloadSchedules := &infrav1alpha1.WorkloadScheduleList{}
-	if err := d.Client.List(ctx, workloadSchedules); err != nil {
+	if err := d.Client.List(ctx, workloadSchedules, client.MatchingFields{index.TargetNamespaceField: namespace}); err != nil {
 		podlog.Error(err, "Failed to list WorkloadSchedules")
 		// Don't block pod creation on error
 		return nil
 	}
 
-	// Check if any WorkloadSchedule targets this namespace
-	var matchingSchedule *infrav1alpha1.WorkloadSchedule
-	for i := range workloadSchedules.Items {
-		ws := &workloadSchedules.Items[i]
-		if ws.Spec.TargetNamespace == namespace {
-			matchingSchedule = ws
-			break
-		}
+	// Schedules from other namespaces only apply with a grant; on error the namespace's own still do
+	permitted, err := permittedSchedules(ctx, d.Client, namespace, workloadSchedules.Items)
+	if err != nil {
+		podlog.Error(err, "Failed to check ScheduleTargetGrants", "namespace", namespace)
 	}
 
-	if matchingSchedule == nil {
+	if len(permitted) == 0 {
 		podlog.Info("No WorkloadSchedule found for namespace, skipping mutation", "namespace", namespace)
 		return nil
 	}
 
-	podlog.Info("Found matching WorkloadSchedule", "name", matchingSchedule.Name, "namespace", namespace)
+	// Only mutate pods that belong to a scheduled Deployment, not every pod in the namespace
+	matching := d.schedulesForPod(ctx, pod, permitted)
+	if len(matching) == 0 {
+		podlog.Info("Pod does not belong to a scheduled workload, skipping mutation", "namespace", namespace)
+		return nil
+	}
+
+	matchingSchedule := selectSchedule(matching)
+	podlog.Info("Found matching WorkloadSchedule", "name", matchingSchedule.Name, "namespace", namespace,
+		"candidates", len(matching))
+
+	policy := matchingSchedule.Spec.PodInjection
+	if policy == infrav1alpha1.PodInjectionDisabled {
+		podlog.Info("Pod injection is disabled for WorkloadSchedule, skipping mutation", "name", matchingSchedule.Name)
+		return nil
+	}
 
 	// Determine the active status from the WorkloadSchedule status
 	isActive := matchingSchedule.Status.WithinActiveWindow
@@ -112,42 +172,147 @@ func (d *PodCustomDefaulter) Default(ctx context.Context, obj runtime.Object) er
 	}
 	pod.Labels[ActiveLabel] = activeValue
 
-	// Inject environment variable into all containers
-	envVar := corev1.EnvVar{
-		Name:  ActiveEnvVar,
-		Value: activeValue,
+	// Record which schedule was applied so the decision can be audited
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
 	}
+	pod.Annotations[AppliedScheduleAnnotation] = matchingSchedule.Namespace + "/" + matchingSchedule.Name
 
-	for i := range pod.Spec.Containers {
-		// Check if env var already exists
+	// Annotate the selected schedule details; LabelOnly stops here and leaves containers and volumes alone
+	metadataEnvVars := injectScheduleMetadata(pod, matchingSchedule)
+	if policy == infrav1alpha1.PodInjectionLabelOnly {
+		podlog.Info("Successfully mutated Pod metadata", "name", pod.GetName(), "namespace", namespace, "active", activeValue)
+		return nil
+	}
+
+	// Inject environment variables into all containers, including init containers
+	envVars := append([]corev1.EnvVar{{Name: ActiveEnvVar, Value: activeValue}}, metadataEnvVars...)
+	for _, envVar := range envVars {
+		upsertEnv(pod.Spec.Containers, envVar)
+		upsertEnv(pod.Spec.InitContainers, envVar)
+	}
+
+	// The env vars are frozen at creation; the mounted state follows the schedule while the pod runs
+	mountStateVolume(pod, infrav1alpha1.StateConfigMapName(matchingSchedule.Spec.TargetDeployment))
+
+	podlog.Info("Successfully mutated Pod", "name", pod.GetName(), "namespace", namespace, "active", activeValue)
+	return nil
+}
+
+// selectSchedule picks the schedule to apply when several target the Pod's workload: the highest
+// priority wins, ties go to the oldest schedule, then to namespace/name order. This is the order the
+// controller resolves conflicts in, so the Pod follows the schedule that acts, independent of list order.
+func selectSchedule(schedules []*infrav1alpha1.WorkloadSchedule) *infrav1alpha1.WorkloadSchedule {
+	sort.SliceStable(schedules, func(i, j int) bool {
+		return infrav1alpha1.SchedulePrecedes(schedules[i], schedules[j])
+	})
+	return schedules[0]
+}
+
+// injectScheduleMetadata adds the annotations selected by the schedule's PodMetadata and returns the
+// matching environment variables. Times the controller has not computed yet are skipped.
+func injectScheduleMetadata(pod *corev1.Pod, ws *infrav1alpha1.WorkloadSchedule) []corev1.EnvVar {
+	var envVars []corev1.EnvVar
+	// The schedule's name is always recorded in AppliedScheduleAnnotation
+	if slices.Contains(ws.Spec.PodMetadata, infrav1alpha1.PodMetadataScheduleName) {
+		envVars = append(envVars, corev1.EnvVar{Name: ScheduleNameEnvVar, Value: ws.Name})
+	}
+
+	// Render times in the schedule's timezone so they match the window hours
+	location, err := time.LoadLocation(ws.ScheduleTimezone())
+	if err != nil {
+		location = time.UTC
+	}
+	timeFields := []struct {
+		field      infrav1alpha1.PodMetadataField
+		value      *metav1.Time
+		envVar     string
+		annotation string
+	}{
+		{infrav1alpha1.PodMetadataWindowEnd, ws.Status.WindowEnd, WindowEndEnvVar, WindowEndAnnotation},
+		{infrav1alpha1.PodMetadataNextTransition, ws.Status.NextTransition, NextTransitionEnvVar, NextTransitionAnnotation},
+	}
+	for _, f := range timeFields {
+		if f.value == nil || !slices.Contains(ws.Spec.PodMetadata, f.field) {
+			continue
+		}
+		value := f.value.In(location).Format(time.RFC3339)
+		pod.Annotations[f.annotation] = value
+		envVars = append(envVars, corev1.EnvVar{Name: f.envVar, Value: value})
+	}
+
+	if slices.Contains(ws.Spec.PodMetadata, infrav1alpha1.PodMetadataTimezone) {
+		pod.Annotations[TimezoneAnnotation] = ws.ScheduleTimezone()
+		envVars = append(envVars, corev1.EnvVar{Name: TimezoneEnvVar, Value: ws.ScheduleTimezone()})
+	}
+	return envVars
+}
+
+// upsertEnv sets envVar on every container, replacing an existing variable with the same name
+func upsertEnv(containers []corev1.Container, envVar corev1.EnvVar) {
+	for i := range containers {
 		found := false
-		for j := range pod.Spec.Containers[i].Env {
-			if pod.Spec.Containers[i].Env[j].Name == ActiveEnvVar {
-				pod.Spec.Containers[i].Env[j].Value = activeValue
+		for j := range containers[i].Env {
+			if containers[i].Env[j].Name == envVar.Name {
+				containers[i].Env[j].Value = envVar.Value
 				found = true
 				break
 			}
 		}
 		if !found {
-			pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, envVar)
+			containers[i].Env = append(containers[i].Env, envVar)
 		}
 	}
+}
 
-	// Also inject into init containers
-	for i := range pod.Spec.InitContainers {
-		found := false
-		for j := range pod.Spec.InitContainers[i].Env {
-			if pod.Spec.InitContainers[i].Env[j].Name == ActiveEnvVar {
-				pod.Spec.InitContainers[i].Env[j].Value = activeValue
-				found = true
-				break
-			}
+// mountStateVolume projects the state ConfigMap into the pod and mounts it read-only in every container.
+// The ConfigMap is optional so pods still start before the controller has written it.
+func mountStateVolume(pod *corev1.Pod, configMapName string) {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == StateVolumeName {
+			return
 		}
-		if !found {
-			pod.Spec.InitContainers[i].Env = append(pod.Spec.InitContainers[i].Env, envVar)
+	}
+
+	optional := true
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: StateVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{{
+					ConfigMap: &corev1.ConfigMapProjection{
+						LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+						Optional:             &optional,
+					},
+				}},
+			},
+		},
+	})
+
+	mount := corev1.VolumeMount{Name: StateVolumeName, MountPath: StateMountPath, ReadOnly: true}
+	for i := range pod.Spec.Containers {
+		// Leave containers alone that already use the path, a duplicate mount would be rejected
+		if slices.ContainsFunc(pod.Spec.Containers[i].VolumeMounts, func(m corev1.VolumeMount) bool {
+			return m.MountPath == StateMountPath
+		}) {
+			continue
 		}
+		pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, mount)
 	}
+}
 
-	podlog.Info("Successfully mutated Pod", "name", pod.GetName(), "namespace", namespace, "active", activeValue)
-	return nil
+// optedOut reports whether an object opted out of injection through InjectAnnotation, as an annotation or a label
+func optedOut(obj *metav1.ObjectMeta) bool {
+	return obj.Annotations[InjectAnnotation] == "false" || obj.Labels[InjectAnnotation] == "false"
+}
+
+// namespaceOptedOut reports whether the namespace opted out of injection. Lookup errors do not opt
+// out, in line with the webhook never blocking or skipping pods because of its own failures.
+func (d *PodCustomDefaulter) namespaceOptedOut(ctx context.Context, namespace string) bool {
+	ns := &corev1.Namespace{}
+	if err := d.Client.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		podlog.Info("Failed to get namespace, assuming it did not opt out", "namespace", namespace, "error", err.Error())
+		return false
+	}
+	return optedOut(&ns.ObjectMeta)
 }
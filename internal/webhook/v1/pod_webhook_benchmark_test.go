@@ -0,0 +1,142 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+// indexedScheduleReader serves WorkloadSchedule lists from a client-go indexer, the same
+// structure backing the manager's cache, so the benchmark measures an indexed lookup
+// rather than the fake client's linear filtering. Other reads go to the embedded client.
+type indexedScheduleReader struct {
+	client.Client
+	indexer toolscache.Indexer
+}
+
+func newIndexedScheduleReader(b *testing.B, schedules int) *indexedScheduleReader {
+	b.Helper()
+	indexer := toolscache.NewIndexer(toolscache.MetaNamespaceKeyFunc, toolscache.Indexers{
+		index.TargetNamespaceField: func(obj interface{}) ([]string, error) {
+			return index.ByTargetNamespace(obj.(client.Object)), nil
+		},
+	})
+	objs := make([]client.Object, 0, schedules)
+	for i := range schedules {
+		// Each team schedules its own namespace, so no ScheduleTargetGrant is needed
+		namespace := fmt.Sprintf("team-%d", i)
+		ws := &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("schedule-%d", i), Namespace: namespace},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				TargetNamespace:  namespace,
+				TargetDeployment: "app",
+			},
+			Status: infrav1alpha1.WorkloadScheduleStatus{WithinActiveWindow: true},
+		}
+		if err := indexer.Add(ws); err != nil {
+			b.Fatal(err)
+		}
+
+		replicaSet := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "app-5d4f8", Namespace: ws.Spec.TargetNamespace}}
+		replicaSet.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app"}}, appsv1.SchemeGroupVersion.WithKind("Deployment"))}
+		objs = append(objs, replicaSet)
+	}
+
+	testScheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(testScheme); err != nil {
+		b.Fatal(err)
+	}
+	if err := infrav1alpha1.AddToScheme(testScheme); err != nil {
+		b.Fatal(err)
+	}
+	return &indexedScheduleReader{
+		Client:  fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objs...).Build(),
+		indexer: indexer,
+	}
+}
+
+func (r *indexedScheduleReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	schedules, ok := list.(*infrav1alpha1.WorkloadScheduleList)
+	if !ok {
+		return r.Client.List(ctx, list, opts...)
+	}
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	if listOpts.FieldSelector == nil {
+		return fmt.Errorf("expected a field selector on %s", index.TargetNamespaceField)
+	}
+	value, ok := listOpts.FieldSelector.RequiresExactMatch(index.TargetNamespaceField)
+	if !ok {
+		return fmt.Errorf("expected an exact match on %s", index.TargetNamespaceField)
+	}
+
+	objs, err := r.indexer.ByIndex(index.TargetNamespaceField, value)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		schedules.Items = append(schedules.Items, *obj.(*infrav1alpha1.WorkloadSchedule))
+	}
+	return nil
+}
+
+// BenchmarkPodDefault measures admission latency as the number of schedules grows; the
+// per-operation time should stay flat because each admission only touches its own namespace
+func BenchmarkPodDefault(b *testing.B) {
+	for _, schedules := range []int{10, 100, 1000, 5000} {
+		b.Run(fmt.Sprintf("schedules=%d", schedules), func(b *testing.B) {
+			defaulter := &PodCustomDefaulter{Client: newIndexedScheduleReader(b, schedules)}
+			ctx := context.Background()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "app",
+						Namespace: fmt.Sprintf("team-%d", i%schedules),
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(
+							&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "app-5d4f8"}},
+							appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))},
+					},
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+				}
+				if err := defaulter.Default(ctx, pod); err != nil {
+					b.Fatal(err)
+				}
+				if pod.Labels[ActiveLabel] != "true" {
+					b.Fatalf("pod %s/%s was not mutated", pod.Namespace, pod.Name)
+				}
+			}
+		})
+	}
+}
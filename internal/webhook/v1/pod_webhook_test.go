@@ -17,13 +17,68 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
-	// TODO (user): Add any additional imports if needed
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
 )
 
+// newFakeClient returns a fake client with the same field indexes the manager's cache registers. The
+// demo namespace grants access to schedules from the default namespace, where newSchedule puts them.
+func newFakeClient(objs ...client.Object) client.Client {
+	testScheme := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(testScheme)).To(Succeed())
+	Expect(infrav1alpha1.AddToScheme(testScheme)).To(Succeed())
+	grant := &infrav1alpha1.ScheduleTargetGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "schedules", Namespace: "demo"},
+		Spec: infrav1alpha1.ScheduleTargetGrantSpec{
+			From: []infrav1alpha1.ScheduleTargetGrantFrom{{Namespace: "default"}},
+		},
+	}
+	return fake.NewClientBuilder().WithScheme(testScheme).WithObjects(append(objs, grant)...).
+		WithIndex(&infrav1alpha1.WorkloadSchedule{}, index.TargetNamespaceField, index.ByTargetNamespace).
+		Build()
+}
+
+// newSchedule returns a WorkloadSchedule targeting the given namespace and deployment
+func newSchedule(name, targetNamespace, targetDeployment string, active bool) *infrav1alpha1.WorkloadSchedule {
+	return &infrav1alpha1.WorkloadSchedule{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: infrav1alpha1.WorkloadScheduleSpec{
+			Timezone:           "America/Toronto",
+			StartHour:          9,
+			EndHour:            17,
+			TargetNamespace:    targetNamespace,
+			TargetDeployment:   targetDeployment,
+			ReplicasWhenActive: 2,
+		},
+		Status: infrav1alpha1.WorkloadScheduleStatus{WithinActiveWindow: active},
+	}
+}
+
+// newReplicaSet returns a ReplicaSet in the demo namespace controlled by the given Deployment
+func newReplicaSet(name, deployment string) *appsv1.ReplicaSet {
+	replicaSet := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "demo"}}
+	if deployment != "" {
+		replicaSet.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: deployment}},
+			appsv1.SchemeGroupVersion.WithKind("Deployment"))}
+	}
+	return replicaSet
+}
+
 var _ = Describe("Pod Webhook", func() {
 	var (
 		obj       *corev1.Pod
@@ -32,30 +87,244 @@ var _ = Describe("Pod Webhook", func() {
 	)
 
 	BeforeEach(func() {
-		obj = &corev1.Pod{}
+		obj = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "demo-pod",
+				Namespace: "demo",
+				Labels:    map[string]string{"app": "demo"},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(
+					newReplicaSet("demo-deployment-5d4f8", ""), appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))},
+			},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "init"}},
+				Containers:     []corev1.Container{{Name: "app"}},
+			},
+		}
 		oldObj = &corev1.Pod{}
-		defaulter = PodCustomDefaulter{}
+		defaulter = PodCustomDefaulter{Client: newFakeClient()}
 		Expect(defaulter).NotTo(BeNil(), "Expected defaulter to be initialized")
 		Expect(oldObj).NotTo(BeNil(), "Expected oldObj to be initialized")
 		Expect(obj).NotTo(BeNil(), "Expected obj to be initialized")
-		// TODO (user): Add any setup logic common to all tests
-	})
-
-	AfterEach(func() {
-		// TODO (user): Add any teardown logic common to all tests
 	})
 
 	Context("When creating Pod under Defaulting Webhook", func() {
-		// TODO (user): Add logic for defaulting webhooks
-		// Example:
-		// It("Should apply defaults when a required field is empty", func() {
-		//     By("simulating a scenario where defaults should be applied")
-		//     obj.SomeFieldWithDefault = ""
-		//     By("calling the Default method to apply defaults")
-		//     defaulter.Default(ctx, obj)
-		//     By("checking that the default values are set")
-		//     Expect(obj.SomeFieldWithDefault).To(Equal("default_value"))
-		// })
+		It("Should inject the active label and env var for pods of the target deployment", func() {
+			defaulter.Client = newFakeClient(
+				newReplicaSet("demo-deployment-5d4f8", "demo-deployment"),
+				newSchedule("other", "elsewhere", "other-deployment", false),
+				newSchedule("business-hours", "demo", "demo-deployment", true),
+			)
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).To(HaveKeyWithValue(ActiveLabel, "true"))
+			Expect(obj.Annotations).To(HaveKeyWithValue(AppliedScheduleAnnotation, "default/business-hours"))
+			Expect(obj.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: ActiveEnvVar, Value: "true"}))
+			Expect(obj.Spec.InitContainers[0].Env).To(ContainElement(corev1.EnvVar{Name: ActiveEnvVar, Value: "true"}))
+		})
+
+		It("Should apply the highest priority schedule, then the oldest, then the alphabetically first", func() {
+			zeta := newSchedule("zeta", "demo", "demo-deployment", true)
+			alpha := newSchedule("alpha", "demo", "demo-deployment", false)
+			unrelated := newSchedule("aardvark", "demo", "batch-deployment", true)
+			unrelated.Spec.Priority = 100
+			replicaSet := newReplicaSet("demo-deployment-5d4f8", "demo-deployment")
+
+			By("breaking a priority tie by name")
+			defaulter.Client = newFakeClient(replicaSet, zeta, alpha, unrelated)
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Annotations).To(HaveKeyWithValue(AppliedScheduleAnnotation, "default/alpha"))
+			Expect(obj.Labels).To(HaveKeyWithValue(ActiveLabel, "false"))
+
+			By("preferring the oldest schedule on a priority tie, as the controller does")
+			zeta.CreationTimestamp = metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+			alpha.CreationTimestamp = metav1.NewTime(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+			defaulter.Client = newFakeClient(replicaSet, alpha, zeta, unrelated)
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Annotations).To(HaveKeyWithValue(AppliedScheduleAnnotation, "default/zeta"))
+
+			By("preferring the higher priority")
+			zeta.CreationTimestamp = alpha.CreationTimestamp
+			alpha.CreationTimestamp = metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+			zeta.Spec.Priority = 10
+			defaulter.Client = newFakeClient(replicaSet, alpha, zeta, unrelated)
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Annotations).To(HaveKeyWithValue(AppliedScheduleAnnotation, "default/zeta"))
+			Expect(obj.Labels).To(HaveKeyWithValue(ActiveLabel, "true"))
+			Expect(obj.Spec.Containers[0].Env).To(ConsistOf(corev1.EnvVar{Name: ActiveEnvVar, Value: "true"}))
+		})
+
+		It("Should inject the schedule metadata selected by podMetadata", func() {
+			schedule := newSchedule("business-hours", "demo", "demo-deployment", true)
+			schedule.Spec.PodMetadata = []infrav1alpha1.PodMetadataField{
+				infrav1alpha1.PodMetadataScheduleName,
+				infrav1alpha1.PodMetadataWindowEnd,
+				infrav1alpha1.PodMetadataNextTransition,
+				infrav1alpha1.PodMetadataTimezone,
+			}
+			windowEnd := metav1.NewTime(time.Date(2025, 1, 6, 22, 0, 0, 0, time.UTC))
+			schedule.Status.WindowEnd = &windowEnd
+			schedule.Status.NextTransition = &windowEnd
+			defaulter.Client = newFakeClient(newReplicaSet("demo-deployment-5d4f8", "demo-deployment"), schedule)
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Annotations).To(HaveKeyWithValue(WindowEndAnnotation, "2025-01-06T17:00:00-05:00"))
+			Expect(obj.Annotations).To(HaveKeyWithValue(NextTransitionAnnotation, "2025-01-06T17:00:00-05:00"))
+			Expect(obj.Annotations).To(HaveKeyWithValue(TimezoneAnnotation, "America/Toronto"))
+			for _, container := range []corev1.Container{obj.Spec.Containers[0], obj.Spec.InitContainers[0]} {
+				Expect(container.Env).To(ConsistOf(
+					corev1.EnvVar{Name: ActiveEnvVar, Value: "true"},
+					corev1.EnvVar{Name: ScheduleNameEnvVar, Value: "business-hours"},
+					corev1.EnvVar{Name: WindowEndEnvVar, Value: "2025-01-06T17:00:00-05:00"},
+					corev1.EnvVar{Name: NextTransitionEnvVar, Value: "2025-01-06T17:00:00-05:00"},
+					corev1.EnvVar{Name: TimezoneEnvVar, Value: "America/Toronto"},
+				))
+			}
+
+			By("skipping times the controller has not computed yet")
+			schedule.Status.WindowEnd = nil
+			schedule.Spec.PodMetadata = []infrav1alpha1.PodMetadataField{infrav1alpha1.PodMetadataWindowEnd}
+			other := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-pod", Namespace: "demo", OwnerReferences: obj.OwnerReferences},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			}
+			defaulter.Client = newFakeClient(newReplicaSet("demo-deployment-5d4f8", "demo-deployment"), schedule)
+			Expect(defaulter.Default(ctx, other)).To(Succeed())
+			Expect(other.Annotations).NotTo(HaveKey(WindowEndAnnotation))
+			Expect(other.Spec.Containers[0].Env).To(ConsistOf(corev1.EnvVar{Name: ActiveEnvVar, Value: "true"}))
+		})
+
+		It("Should mount the schedule's state ConfigMap into every container once", func() {
+			defaulter.Client = newFakeClient(
+				newReplicaSet("demo-deployment-5d4f8", "demo-deployment"),
+				newSchedule("business-hours", "demo", "demo-deployment", true),
+			)
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Volumes).To(HaveLen(1))
+			Expect(obj.Spec.Volumes[0].Name).To(Equal(StateVolumeName))
+			Expect(obj.Spec.Volumes[0].Projected).NotTo(BeNil())
+			Expect(obj.Spec.Volumes[0].Projected.Sources[0].ConfigMap.Name).To(Equal("demo-deployment-workload-schedule"))
+			Expect(*obj.Spec.Volumes[0].Projected.Sources[0].ConfigMap.Optional).To(BeTrue())
+			Expect(obj.Spec.Containers[0].VolumeMounts).To(ConsistOf(
+				corev1.VolumeMount{Name: StateVolumeName, MountPath: StateMountPath, ReadOnly: true}))
+
+			By("not mounting it twice when the pod is mutated again")
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Volumes).To(HaveLen(1))
+			Expect(obj.Spec.Containers[0].VolumeMounts).To(HaveLen(1))
+		})
+
+		It("Should honour the opt-out annotation on pods and namespaces", func() {
+			replicaSet := newReplicaSet("demo-deployment-5d4f8", "demo-deployment")
+			schedule := newSchedule("business-hours", "demo", "demo-deployment", true)
+
+			By("skipping a pod that opted out")
+			obj.Annotations = map[string]string{InjectAnnotation: "false"}
+			defaulter.Client = newFakeClient(replicaSet, schedule)
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).NotTo(HaveKey(ActiveLabel))
+
+			By("skipping pods in a namespace that opted out")
+			obj.Annotations = nil
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:        "demo",
+				Annotations: map[string]string{InjectAnnotation: "false"},
+			}}
+			defaulter.Client = newFakeClient(replicaSet, schedule, namespace)
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).NotTo(HaveKey(ActiveLabel))
+			Expect(obj.Spec.Containers[0].Env).To(BeEmpty())
+
+			By("mutating pods again once the namespace opts back in")
+			namespace.Annotations[InjectAnnotation] = "true"
+			defaulter.Client = newFakeClient(replicaSet, schedule, namespace)
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).To(HaveKeyWithValue(ActiveLabel, "true"))
+		})
+
+		It("Should apply the schedule's podInjection policy", func() {
+			replicaSet := newReplicaSet("demo-deployment-5d4f8", "demo-deployment")
+			schedule := newSchedule("business-hours", "demo", "demo-deployment", true)
+
+			By("leaving pods untouched when injection is disabled")
+			schedule.Spec.PodInjection = infrav1alpha1.PodInjectionDisabled
+			defaulter.Client = newFakeClient(replicaSet, schedule)
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).NotTo(HaveKey(ActiveLabel))
+			Expect(obj.Annotations).To(BeEmpty())
+
+			By("only adding labels and annotations with LabelOnly")
+			schedule.Spec.PodInjection = infrav1alpha1.PodInjectionLabelOnly
+			schedule.Spec.PodMetadata = []infrav1alpha1.PodMetadataField{infrav1alpha1.PodMetadataTimezone}
+			defaulter.Client = newFakeClient(replicaSet, schedule)
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).To(HaveKeyWithValue(ActiveLabel, "true"))
+			Expect(obj.Annotations).To(HaveKeyWithValue(TimezoneAnnotation, "America/Toronto"))
+			Expect(obj.Spec.Containers[0].Env).To(BeEmpty())
+			Expect(obj.Spec.InitContainers[0].Env).To(BeEmpty())
+			Expect(obj.Spec.Volumes).To(BeEmpty())
+		})
+
+		It("Should leave pods in unscheduled namespaces untouched", func() {
+			defaulter.Client = newFakeClient(
+				newReplicaSet("demo-deployment-5d4f8", "demo-deployment"),
+				newSchedule("other", "elsewhere", "other-deployment", true),
+			)
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).NotTo(HaveKey(ActiveLabel))
+			Expect(obj.Spec.Containers[0].Env).To(BeEmpty())
+			Expect(obj.Spec.Volumes).To(BeEmpty())
+		})
+
+		It("Should leave pods of other workloads in a scheduled namespace untouched", func() {
+			schedule := newSchedule("business-hours", "demo", "demo-deployment", true)
+
+			By("skipping pods of another deployment")
+			defaulter.Client = newFakeClient(newReplicaSet("demo-deployment-5d4f8", "batch-deployment"), schedule)
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).NotTo(HaveKey(ActiveLabel))
+
+			By("skipping bare pods and pods owned by Jobs, even when the labels match")
+			obj.OwnerReferences = nil
+			defaulter.Client = newFakeClient(schedule, &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "demo-deployment", Namespace: "demo"},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "demo"}},
+				},
+			})
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).NotTo(HaveKey(ActiveLabel))
+
+			obj.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "report"}}, batchv1.SchemeGroupVersion.WithKind("Job"))}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).NotTo(HaveKey(ActiveLabel))
+			Expect(obj.Spec.Containers[0].Env).To(BeEmpty())
+		})
+
+		It("Should fall back to the deployment selector when the ReplicaSet is not cached yet", func() {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "demo-deployment", Namespace: "demo"},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "demo"}},
+				},
+			}
+			defaulter.Client = newFakeClient(deployment, newSchedule("business-hours", "demo", "demo-deployment", false))
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).To(HaveKeyWithValue(ActiveLabel, "false"))
+
+			By("not matching pods outside the selector")
+			other := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:            "other-pod",
+				Namespace:       "demo",
+				Labels:          map[string]string{"app": "other"},
+				OwnerReferences: obj.OwnerReferences,
+			}}
+			Expect(defaulter.Default(ctx, other)).To(Succeed())
+			Expect(other.Labels).NotTo(HaveKey(ActiveLabel))
+		})
 	})
 
 })
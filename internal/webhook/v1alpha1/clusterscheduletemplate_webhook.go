@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// log is for logging in this package.
+var clusterscheduletemplatelog = logf.Log.WithName("clusterscheduletemplate-resource")
+
+// SetupClusterScheduleTemplateWebhookWithManager registers the webhook for ClusterScheduleTemplate in the manager.
+func SetupClusterScheduleTemplateWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&infrav1alpha1.ClusterScheduleTemplate{}).
+		WithValidator(&ClusterScheduleTemplateCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infra-illumin-com-v1alpha1-clusterscheduletemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=infra.illumin.com,resources=clusterscheduletemplates,verbs=create;update,versions=v1alpha1,name=vclusterscheduletemplate-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ClusterScheduleTemplateCustomValidator struct is responsible for validating the ClusterScheduleTemplate
+// resource when it is created or updated.
+type ClusterScheduleTemplateCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ClusterScheduleTemplateCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ClusterScheduleTemplate.
+func (v *ClusterScheduleTemplateCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	template, ok := obj.(*infrav1alpha1.ClusterScheduleTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterScheduleTemplate object but got %T", obj)
+	}
+	clusterscheduletemplatelog.Info("Validation for ClusterScheduleTemplate upon creation", "name", template.GetName())
+
+	return nil, validateScheduleTemplate("ClusterScheduleTemplate", template.Name, &template.Spec)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ClusterScheduleTemplate.
+func (v *ClusterScheduleTemplateCustomValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	template, ok := newObj.(*infrav1alpha1.ClusterScheduleTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterScheduleTemplate object for the newObj but got %T", newObj)
+	}
+	clusterscheduletemplatelog.Info("Validation for ClusterScheduleTemplate upon update", "name", template.GetName())
+
+	if !template.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	return nil, validateScheduleTemplate("ClusterScheduleTemplate", template.Name, &template.Spec)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ClusterScheduleTemplate.
+func (v *ClusterScheduleTemplateCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("ClusterScheduleTemplate Webhook", func() {
+	var (
+		obj       *infrav1alpha1.ClusterScheduleTemplate
+		validator ClusterScheduleTemplateCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &infrav1alpha1.ClusterScheduleTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "office-hours"},
+			Spec: infrav1alpha1.ScheduleTemplateSpec{
+				Timezone: "Europe/Berlin",
+				Windows:  []infrav1alpha1.ScheduleWindow{{StartHour: 8, EndHour: 18}},
+			},
+		}
+		validator = ClusterScheduleTemplateCustomValidator{}
+	})
+
+	Context("When creating or updating ClusterScheduleTemplate under Validating Webhook", func() {
+		It("Should admit a valid template", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).To(BeEmpty())
+		})
+
+		It("Should deny an unknown timezone on update", func() {
+			oldObj := obj.DeepCopy()
+			obj.Spec.Timezone = "Europe/Atlantis"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.timezone")))
+			Expect(err).To(MatchError(ContainSubstring("ClusterScheduleTemplate")))
+		})
+	})
+})
@@ -0,0 +1,115 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// log is for logging in this package.
+var clusterworkloadschedulelog = logf.Log.WithName("clusterworkloadschedule-resource")
+
+// SetupClusterWorkloadScheduleWebhookWithManager registers the webhook for ClusterWorkloadSchedule in the manager.
+func SetupClusterWorkloadScheduleWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&infrav1alpha1.ClusterWorkloadSchedule{}).
+		WithValidator(&ClusterWorkloadScheduleCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infra-illumin-com-v1alpha1-clusterworkloadschedule,mutating=false,failurePolicy=fail,sideEffects=None,groups=infra.illumin.com,resources=clusterworkloadschedules,verbs=create;update,versions=v1alpha1,name=vclusterworkloadschedule-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ClusterWorkloadScheduleCustomValidator struct is responsible for validating the ClusterWorkloadSchedule
+// resource when it is created or updated.
+type ClusterWorkloadScheduleCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ClusterWorkloadScheduleCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ClusterWorkloadSchedule.
+func (v *ClusterWorkloadScheduleCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cws, ok := obj.(*infrav1alpha1.ClusterWorkloadSchedule)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterWorkloadSchedule object but got %T", obj)
+	}
+	clusterworkloadschedulelog.Info("Validation for ClusterWorkloadSchedule upon creation", "name", cws.GetName())
+
+	return nil, validateClusterWorkloadSchedule(cws)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ClusterWorkloadSchedule.
+func (v *ClusterWorkloadScheduleCustomValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	cws, ok := newObj.(*infrav1alpha1.ClusterWorkloadSchedule)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterWorkloadSchedule object for the newObj but got %T", newObj)
+	}
+	clusterworkloadschedulelog.Info("Validation for ClusterWorkloadSchedule upon update", "name", cws.GetName())
+
+	if !cws.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	return nil, validateClusterWorkloadSchedule(cws)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ClusterWorkloadSchedule.
+func (v *ClusterWorkloadScheduleCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateClusterWorkloadSchedule checks the timezone, the window and the selectors
+func validateClusterWorkloadSchedule(cws *infrav1alpha1.ClusterWorkloadSchedule) error {
+	specPath := field.NewPath("spec")
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateTimezone(cws.Spec.Timezone, specPath.Child("timezone"))...)
+	if cws.Spec.StartHour >= cws.Spec.EndHour {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("endHour"), cws.Spec.EndHour,
+			fmt.Sprintf("must be greater than startHour (%d); the window would never be active", cws.Spec.StartHour)))
+	}
+
+	namespaceSelector, err := metav1.LabelSelectorAsSelector(&cws.Spec.NamespaceSelector)
+	switch {
+	case err != nil:
+		allErrs = append(allErrs, field.Invalid(specPath.Child("namespaceSelector"), cws.Spec.NamespaceSelector, err.Error()))
+	case namespaceSelector.Empty():
+		allErrs = append(allErrs, field.Required(specPath.Child("namespaceSelector"),
+			"must select namespaces; use an Exists expression on kubernetes.io/metadata.name to select all of them"))
+	}
+	if cws.Spec.WorkloadSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(cws.Spec.WorkloadSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("workloadSelector"), cws.Spec.WorkloadSelector, err.Error()))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: infrav1alpha1.GroupVersion.Group, Kind: "ClusterWorkloadSchedule"},
+		cws.Name, allErrs)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("ClusterWorkloadSchedule Webhook", func() {
+	var (
+		obj       *infrav1alpha1.ClusterWorkloadSchedule
+		validator ClusterWorkloadScheduleCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &infrav1alpha1.ClusterWorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev-office-hours"},
+			Spec: infrav1alpha1.ClusterWorkloadScheduleSpec{
+				Timezone:          "America/Toronto",
+				StartHour:         8,
+				EndHour:           20,
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+			},
+		}
+		validator = ClusterWorkloadScheduleCustomValidator{}
+	})
+
+	Context("When creating or updating ClusterWorkloadSchedule under Validating Webhook", func() {
+		It("Should admit a valid schedule", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).To(BeEmpty())
+		})
+
+		It("Should deny an empty namespace selector", func() {
+			obj.Spec.NamespaceSelector = metav1.LabelSelector{}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.namespaceSelector")))
+		})
+
+		It("Should deny invalid selectors and windows on update", func() {
+			oldObj := obj.DeepCopy()
+			obj.Spec.EndHour = obj.Spec.StartHour
+			obj.Spec.WorkloadSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "tier", Operator: "Near"},
+			}}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.endHour")))
+			Expect(err).To(MatchError(ContainSubstring("spec.workloadSelector")))
+		})
+	})
+})
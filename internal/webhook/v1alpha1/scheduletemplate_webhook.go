@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+// log is for logging in this package.
+var scheduletemplatelog = logf.Log.WithName("scheduletemplate-resource")
+
+// SetupScheduleTemplateWebhookWithManager registers the webhook for ScheduleTemplate in the manager.
+func SetupScheduleTemplateWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&infrav1alpha1.ScheduleTemplate{}).
+		WithValidator(&ScheduleTemplateCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infra-illumin-com-v1alpha1-scheduletemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=infra.illumin.com,resources=scheduletemplates,verbs=create;update,versions=v1alpha1,name=vscheduletemplate-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ScheduleTemplateCustomValidator struct is responsible for validating the ScheduleTemplate
+// resource when it is created or updated.
+type ScheduleTemplateCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ScheduleTemplateCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ScheduleTemplate.
+func (v *ScheduleTemplateCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	template, ok := obj.(*infrav1alpha1.ScheduleTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a ScheduleTemplate object but got %T", obj)
+	}
+	scheduletemplatelog.Info("Validation for ScheduleTemplate upon creation", "name", template.GetName())
+
+	return nil, validateScheduleTemplate("ScheduleTemplate", template.Name, &template.Spec)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ScheduleTemplate.
+func (v *ScheduleTemplateCustomValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	template, ok := newObj.(*infrav1alpha1.ScheduleTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a ScheduleTemplate object for the newObj but got %T", newObj)
+	}
+	scheduletemplatelog.Info("Validation for ScheduleTemplate upon update", "name", template.GetName())
+
+	if !template.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	return nil, validateScheduleTemplate("ScheduleTemplate", template.Name, &template.Spec)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ScheduleTemplate.
+func (v *ScheduleTemplateCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateScheduleTemplate checks the timezone and holidays of a ScheduleTemplate or
+// ClusterScheduleTemplate, which the CRD schema can only check for shape. A template the controller
+// cannot evaluate would stop every schedule referencing it.
+func validateScheduleTemplate(kind, name string, spec *infrav1alpha1.ScheduleTemplateSpec) error {
+	specPath := field.NewPath("spec")
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateTimezone(spec.Timezone, specPath.Child("timezone"))...)
+	for i, holiday := range spec.Holidays {
+		if _, err := time.Parse(time.DateOnly, holiday.Date); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("holidays").Index(i).Child("date"), holiday.Date,
+				"must be a calendar date formatted as YYYY-MM-DD"))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(schema.GroupKind{Group: infrav1alpha1.GroupVersion.Group, Kind: kind}, name, allErrs)
+}
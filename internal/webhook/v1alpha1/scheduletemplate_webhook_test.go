@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+)
+
+var _ = Describe("ScheduleTemplate Webhook", func() {
+	var (
+		obj       *infrav1alpha1.ScheduleTemplate
+		validator ScheduleTemplateCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &infrav1alpha1.ScheduleTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "office-hours", Namespace: "demo"},
+			Spec: infrav1alpha1.ScheduleTemplateSpec{
+				Timezone: "America/Toronto",
+				Windows:  []infrav1alpha1.ScheduleWindow{{StartHour: 9, EndHour: 17}},
+				Holidays: []infrav1alpha1.Holiday{{Date: "2025-12-25", Name: "Christmas"}},
+			},
+		}
+		validator = ScheduleTemplateCustomValidator{}
+	})
+
+	Context("When creating or updating ScheduleTemplate under Validating Webhook", func() {
+		It("Should admit a valid template", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).To(BeEmpty())
+		})
+
+		It("Should deny an unknown timezone", func() {
+			obj.Spec.Timezone = "Mars/Olympus"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.timezone")))
+		})
+
+		It("Should deny the host's local timezone on update", func() {
+			oldObj := obj.DeepCopy()
+			obj.Spec.Timezone = "Local"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.timezone")))
+		})
+
+		It("Should deny a holiday that is not a calendar date", func() {
+			obj.Spec.Holidays = append(obj.Spec.Holidays, infrav1alpha1.Holiday{Date: "2025-02-30"})
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.holidays[1].date")))
+		})
+	})
+})
@@ -0,0 +1,350 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	// Embed the IANA timezone database so validation does not depend on the image's tzdata
+	_ "time/tzdata"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+// log is for logging in this package.
+var workloadschedulelog = logf.Log.WithName("workloadschedule-resource")
+
+// SetupWorkloadScheduleWebhookWithManager registers the webhook for WorkloadSchedule in the manager. It
+// queries the index.TargetField index, which must already be registered. watchNamespaces are the
+// namespaces the operator is limited to, as with its --watch-namespaces flag, or empty for all.
+func SetupWorkloadScheduleWebhookWithManager(mgr ctrl.Manager, watchNamespaces []string) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&infrav1alpha1.WorkloadSchedule{}).
+		WithValidator(&WorkloadScheduleCustomValidator{Client: mgr.GetClient(), WatchNamespaces: watchNamespaces}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infra-illumin-com-v1alpha1-workloadschedule,mutating=false,failurePolicy=fail,sideEffects=None,groups=infra.illumin.com,resources=workloadschedules,verbs=create;update,versions=v1alpha1,name=vworkloadschedule-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// WorkloadScheduleCustomValidator struct is responsible for validating the WorkloadSchedule resource
+// when it is created or updated.
+type WorkloadScheduleCustomValidator struct {
+	Client client.Client
+
+	// WatchNamespaces limits the namespaces schedules can target to those the operator watches.
+	// Empty means all namespaces.
+	WatchNamespaces []string
+}
+
+var _ webhook.CustomValidator = &WorkloadScheduleCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type WorkloadSchedule.
+func (v *WorkloadScheduleCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	workloadschedule, ok := obj.(*infrav1alpha1.WorkloadSchedule)
+	if !ok {
+		return nil, fmt.Errorf("expected a WorkloadSchedule object but got %T", obj)
+	}
+	workloadschedulelog.Info("Validation for WorkloadSchedule upon creation", "name", workloadschedule.GetName())
+
+	return v.validateWorkloadSchedule(ctx, workloadschedule)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type WorkloadSchedule.
+func (v *WorkloadScheduleCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	workloadschedule, ok := newObj.(*infrav1alpha1.WorkloadSchedule)
+	if !ok {
+		return nil, fmt.Errorf("expected a WorkloadSchedule object for the newObj but got %T", newObj)
+	}
+	workloadschedulelog.Info("Validation for WorkloadSchedule upon update", "name", workloadschedule.GetName())
+
+	// Let objects that are being deleted finish, even if they no longer pass validation
+	if !workloadschedule.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+
+	return v.validateWorkloadSchedule(ctx, workloadschedule)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type WorkloadSchedule.
+func (v *WorkloadScheduleCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateWorkloadSchedule runs every check and aggregates field errors into a single Invalid error
+func (v *WorkloadScheduleCustomValidator) validateWorkloadSchedule(ctx context.Context,
+	ws *infrav1alpha1.WorkloadSchedule) (admission.Warnings, error) {
+	specPath := field.NewPath("spec")
+
+	// Nothing outside the watched namespaces can be read, and the controller would only stand down
+	if len(v.WatchNamespaces) > 0 && !slices.Contains(v.WatchNamespaces, ws.Spec.TargetNamespace) {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: infrav1alpha1.GroupVersion.Group, Kind: "WorkloadSchedule"},
+			ws.Name, field.ErrorList{field.Invalid(specPath.Child("targetNamespace"), ws.Spec.TargetNamespace,
+				fmt.Sprintf("is not watched by the operator (watching %s)", strings.Join(v.WatchNamespaces, ", ")))})
+	}
+
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	if ws.Spec.TemplateRef == nil {
+		allErrs = append(allErrs, validateTimezone(ws.Spec.Timezone, specPath.Child("timezone"))...)
+		allErrs = append(allErrs, validateWindow(&ws.Spec, specPath)...)
+	} else if len(v.WatchNamespaces) > 0 && ws.Spec.TemplateRef.TemplateKind() == infrav1alpha1.ScheduleTemplateKindCluster {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("templateRef", "kind"),
+			"ClusterScheduleTemplates cannot be read by an operator watching only some namespaces"))
+	} else {
+		allErrs = append(allErrs, validateTemplateRef(&ws.Spec, specPath)...)
+		templateWarnings, err := v.templateWarnings(ctx, ws)
+		if err != nil {
+			return nil, err
+		}
+		warnings = append(warnings, templateWarnings...)
+	}
+	allErrs = append(allErrs, validateRamp(ws.Spec.RampStrategy, specPath.Child("rampStrategy"))...)
+
+	grantErrs, err := v.validateTargetGrant(ctx, ws, specPath.Child("targetNamespace"))
+	if err != nil {
+		return nil, err
+	}
+	allErrs = append(allErrs, grantErrs...)
+
+	applicationErrs, err := v.validateApplicationGrant(ctx, ws, specPath.Child("actuation", "argoCDApplication"))
+	if err != nil {
+		return nil, err
+	}
+	allErrs = append(allErrs, applicationErrs...)
+
+	conflictErrs, conflictWarnings, err := v.validateNoConflicts(ctx, ws, specPath.Child("priority"))
+	if err != nil {
+		return nil, err
+	}
+	allErrs = append(allErrs, conflictErrs...)
+	warnings = append(warnings, conflictWarnings...)
+
+	targetWarnings, err := v.targetWarnings(ctx, ws)
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, targetWarnings...)
+
+	if len(allErrs) == 0 {
+		return warnings, nil
+	}
+	return warnings, apierrors.NewInvalid(
+		schema.GroupKind{Group: infrav1alpha1.GroupVersion.Group, Kind: "WorkloadSchedule"},
+		ws.Name, allErrs)
+}
+
+// templateWarnings warns about a referenced template that does not exist yet; the schedule does not
+// act until it does
+func (v *WorkloadScheduleCustomValidator) templateWarnings(ctx context.Context,
+	ws *infrav1alpha1.WorkloadSchedule) (admission.Warnings, error) {
+	ref := ws.Spec.TemplateRef
+	var template client.Object = &infrav1alpha1.ScheduleTemplate{}
+	key := types.NamespacedName{Namespace: ws.Namespace, Name: ref.Name}
+	if ref.TemplateKind() == infrav1alpha1.ScheduleTemplateKindCluster {
+		template = &infrav1alpha1.ClusterScheduleTemplate{}
+		key = types.NamespacedName{Name: ref.Name}
+	}
+	err := v.Client.Get(ctx, key, template)
+	if err == nil {
+		return nil, nil
+	}
+	if apierrors.IsNotFound(err) {
+		return admission.Warnings{fmt.Sprintf("%s %s does not exist yet; the schedule will not act until it does",
+			ref.TemplateKind(), ref.Name)}, nil
+	}
+	return nil, fmt.Errorf("failed to get %s: %w", ref.TemplateKind(), err)
+}
+
+// validateTimezone checks that the timezone is a valid IANA name from the tz database
+func validateTimezone(timezone string, fldPath *field.Path) field.ErrorList {
+	// LoadLocation accepts "Local" and "" as aliases for the host's zone, which is not meaningful here
+	if timezone == "" || timezone == "Local" {
+		return field.ErrorList{field.Invalid(fldPath, timezone, "must be an IANA timezone name such as \"America/Toronto\"")}
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, timezone, fmt.Sprintf("unknown IANA timezone: %v", err))}
+	}
+	return nil
+}
+
+// validateWindow checks that the active window opens at all and that lead times fit around it
+func validateWindow(spec *infrav1alpha1.WorkloadScheduleSpec, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.StartHour >= spec.EndHour {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("endHour"), spec.EndHour,
+			fmt.Sprintf("must be greater than startHour (%d); the window would never be active", spec.StartHour)))
+		return allErrs
+	}
+
+	inactive := time.Duration(24-(spec.EndHour-spec.StartHour)) * time.Hour
+	if spec.PreWarm != nil && spec.PreWarm.Duration >= inactive {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("preWarm"), spec.PreWarm.Duration.String(),
+			fmt.Sprintf("must be shorter than the inactive part of the day (%s)", inactive)))
+	}
+
+	return allErrs
+}
+
+// validateRamp checks that ramp steps are spaced out in time
+func validateRamp(ramp *infrav1alpha1.RampStrategy, fldPath *field.Path) field.ErrorList {
+	if ramp != nil && ramp.Interval != nil && ramp.Interval.Duration <= 0 {
+		return field.ErrorList{field.Invalid(fldPath.Child("interval"), ramp.Interval.Duration.String(), "must be positive")}
+	}
+	return nil
+}
+
+// validateTemplateRef rejects inline window fields next to a template reference, since it would be
+// unclear which of them applies
+func validateTemplateRef(spec *infrav1alpha1.WorkloadScheduleSpec, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	const detail = "must not be set together with templateRef; the template defines the windows"
+	if spec.Timezone != "" {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("timezone"), detail))
+	}
+	if spec.StartHour != 0 {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("startHour"), detail))
+	}
+	if spec.EndHour != 0 {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("endHour"), detail))
+	}
+	return allErrs
+}
+
+// validateTargetGrant rejects a schedule targeting another namespace unless a ScheduleTargetGrant
+// there permits it, so nobody can scale a namespace they do not own
+func (v *WorkloadScheduleCustomValidator) validateTargetGrant(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule,
+	fldPath *field.Path) (field.ErrorList, error) {
+	if ws.Namespace == ws.Spec.TargetNamespace {
+		return nil, nil
+	}
+	grants := &infrav1alpha1.ScheduleTargetGrantList{}
+	if err := v.Client.List(ctx, grants, client.InNamespace(ws.Spec.TargetNamespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ScheduleTargetGrants: %w", err)
+	}
+	if infrav1alpha1.TargetPermitted(ws, grants.Items) {
+		return nil, nil
+	}
+	return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf(
+		"no ScheduleTargetGrant in namespace %s allows WorkloadSchedules from namespace %s to target deployment %s",
+		ws.Spec.TargetNamespace, ws.Namespace, ws.Spec.TargetDeployment))}, nil
+}
+
+// validateApplicationGrant rejects a schedule referencing an Argo CD Application in another namespace
+// unless a ScheduleTargetGrant there names it, so nobody can pause an Application they do not own
+func (v *WorkloadScheduleCustomValidator) validateApplicationGrant(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule,
+	fldPath *field.Path) (field.ErrorList, error) {
+	if ws.Spec.Actuation == nil || ws.Spec.Actuation.ArgoCDApplication == nil {
+		return nil, nil
+	}
+	ref := ws.Spec.Actuation.ArgoCDApplication
+	namespace := ref.ApplicationNamespace()
+	if ws.Namespace == namespace {
+		return nil, nil
+	}
+	grants := &infrav1alpha1.ScheduleTargetGrantList{}
+	if err := v.Client.List(ctx, grants, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ScheduleTargetGrants: %w", err)
+	}
+	if infrav1alpha1.ApplicationPermitted(ws, grants.Items) {
+		return nil, nil
+	}
+	return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf(
+		"no ScheduleTargetGrant in namespace %s allows WorkloadSchedules from namespace %s to pause Argo CD Application %s",
+		namespace, ws.Namespace, ref.Name))}, nil
+}
+
+// validateNoConflicts rejects a schedule whose target is already managed by another schedule with
+// the same priority, since it would be ambiguous which one acts. Schedules with different priorities
+// are allowed and only produce a warning naming the one that takes precedence. Like the controller,
+// it ignores schedules that are being deleted or have no grant for the target.
+func (v *WorkloadScheduleCustomValidator) validateNoConflicts(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule,
+	fldPath *field.Path) (field.ErrorList, admission.Warnings, error) {
+	schedules := &infrav1alpha1.WorkloadScheduleList{}
+	if err := v.Client.List(ctx, schedules, client.MatchingFields{
+		index.TargetField: index.TargetKey(ws.Spec.TargetNamespace, ws.Spec.TargetDeployment),
+	}); err != nil {
+		return nil, nil, fmt.Errorf("failed to list WorkloadSchedules: %w", err)
+	}
+
+	grants := &infrav1alpha1.ScheduleTargetGrantList{}
+	if slices.ContainsFunc(schedules.Items, func(other infrav1alpha1.WorkloadSchedule) bool {
+		return other.Namespace != ws.Spec.TargetNamespace
+	}) {
+		if err := v.Client.List(ctx, grants, client.InNamespace(ws.Spec.TargetNamespace)); err != nil {
+			return nil, nil, fmt.Errorf("failed to list ScheduleTargetGrants: %w", err)
+		}
+	}
+
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	for i := range schedules.Items {
+		other := &schedules.Items[i]
+		if other.Namespace == ws.Namespace && other.Name == ws.Name {
+			continue
+		}
+		if !other.DeletionTimestamp.IsZero() || !infrav1alpha1.TargetPermitted(other, grants.Items) {
+			continue
+		}
+
+		switch {
+		case other.Spec.Priority == ws.Spec.Priority:
+			allErrs = append(allErrs, field.Invalid(fldPath, ws.Spec.Priority,
+				fmt.Sprintf("deployment %s/%s is already targeted by WorkloadSchedule %s/%s with the same priority; "+
+					"set a different priority to decide which schedule acts",
+					ws.Spec.TargetNamespace, ws.Spec.TargetDeployment, other.Namespace, other.Name)))
+		case other.Spec.Priority > ws.Spec.Priority:
+			warnings = append(warnings, fmt.Sprintf("deployment %s/%s is also targeted by WorkloadSchedule %s/%s "+
+				"with a higher priority; this schedule will not act while it exists",
+				ws.Spec.TargetNamespace, ws.Spec.TargetDeployment, other.Namespace, other.Name))
+		default:
+			warnings = append(warnings, fmt.Sprintf("deployment %s/%s is also targeted by WorkloadSchedule %s/%s; "+
+				"this schedule takes precedence", ws.Spec.TargetNamespace, ws.Spec.TargetDeployment, other.Namespace, other.Name))
+		}
+	}
+	return allErrs, warnings, nil
+}
+
+// targetWarnings warns, without rejecting, when the target deployment does not exist yet
+func (v *WorkloadScheduleCustomValidator) targetWarnings(ctx context.Context, ws *infrav1alpha1.WorkloadSchedule) (admission.Warnings, error) {
+	deployment := &appsv1.Deployment{}
+	err := v.Client.Get(ctx, types.NamespacedName{Namespace: ws.Spec.TargetNamespace, Name: ws.Spec.TargetDeployment}, deployment)
+	if err == nil {
+		return nil, nil
+	}
+	if apierrors.IsNotFound(err) {
+		return admission.Warnings{fmt.Sprintf("target deployment %s/%s does not exist yet",
+			ws.Spec.TargetNamespace, ws.Spec.TargetDeployment)}, nil
+	}
+	return nil, fmt.Errorf("failed to get target deployment: %w", err)
+}
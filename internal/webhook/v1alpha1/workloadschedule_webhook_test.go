@@ -0,0 +1,233 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "github.com/vmovahed/workload-schedule-operator/api/v1alpha1"
+	"github.com/vmovahed/workload-schedule-operator/internal/index"
+)
+
+var _ = Describe("WorkloadSchedule Webhook", func() {
+	var (
+		obj       *infrav1alpha1.WorkloadSchedule
+		oldObj    *infrav1alpha1.WorkloadSchedule
+		validator WorkloadScheduleCustomValidator
+	)
+
+	newFakeClient := func(objs ...client.Object) client.Client {
+		testScheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(testScheme)).To(Succeed())
+		Expect(infrav1alpha1.AddToScheme(testScheme)).To(Succeed())
+		return fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objs...).
+			WithIndex(&infrav1alpha1.WorkloadSchedule{}, index.TargetField, index.ByTarget).Build()
+	}
+
+	targetDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo-deployment", Namespace: "demo"},
+	}
+
+	targetGrant := &infrav1alpha1.ScheduleTargetGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "schedules", Namespace: "demo"},
+		Spec: infrav1alpha1.ScheduleTargetGrantSpec{
+			From: []infrav1alpha1.ScheduleTargetGrantFrom{{Namespace: "default"}, {Namespace: "team-a"}},
+		},
+	}
+
+	BeforeEach(func() {
+		obj = &infrav1alpha1.WorkloadSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "business-hours", Namespace: "default"},
+			Spec: infrav1alpha1.WorkloadScheduleSpec{
+				Timezone:           "America/Toronto",
+				StartHour:          9,
+				EndHour:            17,
+				TargetNamespace:    "demo",
+				TargetDeployment:   "demo-deployment",
+				ReplicasWhenActive: 2,
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = WorkloadScheduleCustomValidator{Client: newFakeClient(targetDeployment, targetGrant)}
+	})
+
+	Context("When creating or updating WorkloadSchedule under Validating Webhook", func() {
+		It("Should admit a valid schedule without warnings", func() {
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should deny an unknown timezone", func() {
+			obj.Spec.Timezone = "America/Atlantis"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.timezone")))
+		})
+
+		It("Should deny the Local timezone alias", func() {
+			obj.Spec.Timezone = "Local"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.timezone")))
+		})
+
+		It("Should deny a window that never opens", func() {
+			obj.Spec.StartHour = 17
+			obj.Spec.EndHour = 9
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.endHour")))
+		})
+
+		It("Should deny a pre-warm lead time longer than the inactive window", func() {
+			obj.Spec.PreWarm = &metav1.Duration{Duration: 16 * time.Hour}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.preWarm")))
+		})
+
+		It("Should take the windows from a template instead of the inline fields", func() {
+			obj.Spec.TemplateRef = &infrav1alpha1.ScheduleTemplateReference{Name: "office-hours"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.timezone")))
+			Expect(err).To(MatchError(ContainSubstring("spec.startHour")))
+			Expect(err).To(MatchError(ContainSubstring("spec.endHour")))
+
+			By("warning until the template exists")
+			obj.Spec.Timezone, obj.Spec.StartHour, obj.Spec.EndHour = "", 0, 0
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("ScheduleTemplate office-hours does not exist yet")))
+
+			template := &infrav1alpha1.ScheduleTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "office-hours", Namespace: "default"},
+			}
+			validator.Client = newFakeClient(targetDeployment, targetGrant, template)
+			warnings, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should warn when the target deployment does not exist", func() {
+			validator.Client = newFakeClient(targetGrant)
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("demo/demo-deployment")))
+		})
+
+		It("Should deny targeting another namespace without a ScheduleTargetGrant", func() {
+			validator.Client = newFakeClient(targetDeployment)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.targetNamespace")))
+
+			By("denying it when the grant only names another deployment")
+			grant := targetGrant.DeepCopy()
+			grant.Spec.To = []infrav1alpha1.ScheduleTargetGrantTo{{Name: "other-deployment"}}
+			validator.Client = newFakeClient(targetDeployment, grant)
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.targetNamespace")))
+
+			By("admitting a schedule in the target namespace without any grant")
+			obj.Namespace = "demo"
+			validator.Client = newFakeClient(targetDeployment)
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny pausing an Argo CD Application in another namespace without a grant naming it", func() {
+			obj.Spec.Actuation = &infrav1alpha1.Actuation{
+				ArgoCDApplication: &infrav1alpha1.ApplicationReference{Name: "demo"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.actuation.argoCDApplication")))
+
+			By("admitting it once a grant in the Application's namespace names it")
+			applicationGrant := &infrav1alpha1.ScheduleTargetGrant{
+				ObjectMeta: metav1.ObjectMeta{Name: "schedules", Namespace: "argocd"},
+				Spec: infrav1alpha1.ScheduleTargetGrantSpec{
+					From: []infrav1alpha1.ScheduleTargetGrantFrom{{Namespace: "default"}},
+					To: []infrav1alpha1.ScheduleTargetGrantTo{
+						{Kind: infrav1alpha1.GrantTargetKindApplication, Name: "demo"},
+					},
+				},
+			}
+			validator.Client = newFakeClient(targetDeployment, targetGrant, applicationGrant)
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny a target namespace the operator does not watch", func() {
+			validator.WatchNamespaces = []string{"default", "team-a"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.targetNamespace")))
+			Expect(err).To(MatchError(ContainSubstring("not watched by the operator (watching default, team-a)")))
+
+			By("admitting it once the namespace is watched")
+			validator.WatchNamespaces = append(validator.WatchNamespaces, "demo")
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("denying a ClusterScheduleTemplate, which cannot be read either")
+			obj.Spec = infrav1alpha1.WorkloadScheduleSpec{
+				TemplateRef: &infrav1alpha1.ScheduleTemplateReference{
+					Kind: infrav1alpha1.ScheduleTemplateKindCluster,
+					Name: "company-hours",
+				},
+				TargetNamespace:    "demo",
+				TargetDeployment:   "demo-deployment",
+				ReplicasWhenActive: 2,
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.templateRef.kind")))
+		})
+
+		It("Should deny a schedule whose target is already scheduled with the same priority", func() {
+			other := obj.DeepCopy()
+			other.Name = "other"
+			other.Namespace = "team-a"
+			validator.Client = newFakeClient(targetDeployment, targetGrant, other)
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("team-a/other")))
+
+			By("admitting it with a warning once the priorities differ")
+			obj.Spec.Priority = 10
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("takes precedence")))
+
+			By("ignoring a schedule that has no grant for the target, as the controller does")
+			obj.Spec.Priority = 0
+			other.Namespace = "team-b"
+			validator.Client = newFakeClient(targetDeployment, targetGrant, other)
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("allowing the schedule to be updated without conflicting with itself")
+			validator.Client = newFakeClient(targetDeployment, targetGrant, obj.DeepCopy())
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -54,6 +54,8 @@ echo "✓ Demo deployment created"
 # Test 4: Create WorkloadSchedule
 echo ""
 echo "=== Test 4: Create WorkloadSchedule ==="
+# The sample schedule lives in the default namespace, so the demo namespace must grant it access
+kubectl apply -f "${PROJECT_DIR}/config/samples/infra_v1alpha1_scheduletargetgrant.yaml"
 kubectl apply -f "${PROJECT_DIR}/config/samples/infra_v1alpha1_workloadschedule.yaml"
 sleep 5
 